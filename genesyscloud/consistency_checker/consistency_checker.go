@@ -10,7 +10,9 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	featureToggles "terraform-provider-genesyscloud/genesyscloud/util/feature_toggles"
+	"time"
 	"unsafe"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -23,6 +25,7 @@ import (
 var (
 	mcc      map[string]*ConsistencyCheck
 	mccMutex sync.RWMutex
+	disabled atomic.Bool
 )
 
 func init() {
@@ -39,6 +42,27 @@ type ConsistencyCheck struct {
 	checks         int
 	maxStateChecks int
 	resourceType   string
+	maxAttempts    int
+	checkDelay     time.Duration
+}
+
+// ConsistencyCheckOption configures optional behaviour of a ConsistencyCheck
+type ConsistencyCheckOption func(*ConsistencyCheck)
+
+// WithMaxAttempts fails the check with a non-retryable error once the state has been checked maxAttempts times
+// without converging, rather than retrying until the resource timeout is reached
+func WithMaxAttempts(maxAttempts int) ConsistencyCheckOption {
+	return func(c *ConsistencyCheck) {
+		c.maxAttempts = maxAttempts
+	}
+}
+
+// WithCheckDelay waits for the given delay before every check after the first, giving slow to
+// propagate attributes time to settle between reads
+func WithCheckDelay(delay time.Duration) ConsistencyCheckOption {
+	return func(c *ConsistencyCheck) {
+		c.checkDelay = delay
+	}
 }
 
 type consistencyError struct {
@@ -60,9 +84,14 @@ expected value: %v
 actual value:   %v`, e.key, e.oldValue, e.newValue)
 }
 
-func NewConsistencyCheck(ctx context.Context, d *schema.ResourceData, meta interface{}, r *schema.Resource, maxStateChecks int, resourceType string) *ConsistencyCheck {
+// SetDisabled turns consistency checking off for every resource. A disabled check accepts the first state it is given.
+func SetDisabled(disable bool) {
+	disabled.Store(disable)
+}
+
+func NewConsistencyCheck(ctx context.Context, d *schema.ResourceData, meta interface{}, r *schema.Resource, maxStateChecks int, resourceType string, opts ...ConsistencyCheckOption) *ConsistencyCheck {
 	emptyState := isEmptyState(d)
-	if *emptyState {
+	if *emptyState || disabled.Load() {
 		return &ConsistencyCheck{isEmptyState: emptyState}
 	}
 	var cc *ConsistencyCheck
@@ -92,6 +121,9 @@ func NewConsistencyCheck(ctx context.Context, d *schema.ResourceData, meta inter
 		maxStateChecks: maxStateChecks,
 		resourceType:   resourceType,
 	}
+	for _, opt := range opts {
+		opt(cc)
+	}
 	mccMutex.Lock()
 	mcc[d.Id()] = cc
 	mccMutex.Unlock()
@@ -230,6 +262,13 @@ func (c *ConsistencyCheck) CheckState(currentState *schema.ResourceData) *retry.
 		log.Printf("%s is not set, consistency checker behaving as default", featureToggles.CCToggleName())
 	}
 
+	if c.checks > 0 && c.checkDelay > 0 {
+		select {
+		case <-time.After(c.checkDelay):
+		case <-c.ctx.Done():
+		}
+	}
+
 	originalState := filterMap(c.originalState)
 
 	resourceConfig := &terraform.ResourceConfig{
@@ -262,36 +301,12 @@ func (c *ConsistencyCheck) CheckState(currentState *schema.ResourceData) *retry.
 				vv := v.New
 				if currentState.HasChange(k) {
 					if !compareValues(c.originalState[parts[0]], vv, slice1Index, slice2Index, key) {
-						err := retry.RetryableError(&consistencyError{
-							key:      k,
-							oldValue: c.originalState[k],
-							newValue: currentState.Get(k),
-						})
-
-						if exists := featureToggles.CCToggleExists(); c.checks >= c.maxStateChecks && exists {
-							c.writeConsistencyErrorToFile(currentState, err)
-							return nil
-						}
-
-						c.checks++
-						return err
+						return c.mismatch(currentState, k)
 					}
 				}
 			} else {
 				if currentState.HasChange(k) {
-					err := retry.RetryableError(&consistencyError{
-						key:      k,
-						oldValue: c.originalState[k],
-						newValue: currentState.Get(k),
-					})
-
-					if exists := featureToggles.CCToggleExists(); c.checks >= c.maxStateChecks && exists {
-						c.writeConsistencyErrorToFile(currentState, err)
-						return nil
-					}
-
-					c.checks++
-					return err
+					return c.mismatch(currentState, k)
 				}
 			}
 		}
@@ -301,6 +316,27 @@ func (c *ConsistencyCheck) CheckState(currentState *schema.ResourceData) *retry.
 	return nil
 }
 
+// mismatch builds the error returned when attribute k has not converged to its original value
+func (c *ConsistencyCheck) mismatch(currentState *schema.ResourceData, k string) *retry.RetryError {
+	err := retry.RetryableError(&consistencyError{
+		key:      k,
+		oldValue: c.originalState[k],
+		newValue: currentState.Get(k),
+	})
+
+	if exists := featureToggles.CCToggleExists(); c.checks >= c.maxStateChecks && exists {
+		c.writeConsistencyErrorToFile(currentState, err)
+		return nil
+	}
+
+	c.checks++
+	if c.maxAttempts > 0 && c.checks >= c.maxAttempts {
+		DeleteConsistencyCheck(currentState.Id())
+		return retry.NonRetryableError(fmt.Errorf("%s %s did not become consistent after %d attempts: %w", c.resourceType, currentState.Id(), c.checks, err.Err))
+	}
+	return err
+}
+
 func (c *ConsistencyCheck) writeConsistencyErrorToFile(d *schema.ResourceData, consistencyError *retry.RetryError) {
 	const filePath = "consistency-errors.log.json"
 	errorJson := consistencyErrorJson{
@@ -0,0 +1,83 @@
+package consistency_checker
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var testResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"name":        {Type: schema.TypeString, Required: true},
+		"description": {Type: schema.TypeString, Optional: true},
+		"division_id": {Type: schema.TypeString, Optional: true, Computed: true},
+	},
+}
+
+// buildTestState returns resource data for testResource with the given ID and description
+func buildTestState(t *testing.T, id string, description string) *schema.ResourceData {
+	d := schema.TestResourceDataRaw(t, testResource.Schema, map[string]interface{}{"name": "queue", "description": description})
+	d.SetId(id)
+	return d
+}
+
+func TestUnitConsistencyCheckServerPopulatedAttributes(t *testing.T) {
+	const id = "cc-server-populated"
+
+	// division_id is not configured, so the division the server assigns is not compared
+	original := buildTestState(t, id, "set by user")
+	cc := NewConsistencyCheck(context.Background(), original, nil, testResource, 5, "test")
+	defer DeleteConsistencyCheck(id)
+
+	current := buildTestState(t, id, "set by user")
+	_ = current.Set("division_id", "home-division")
+	if err := cc.CheckState(current); err != nil {
+		t.Errorf("expected a server populated attribute not to cause a retry, got: %v", err.Err)
+	}
+	if cc.checks != 0 {
+		t.Errorf("expected no retries to be counted, got %d", cc.checks)
+	}
+
+	// A configured attribute the server rewrote is still retried
+	original = buildTestState(t, id, "set by user")
+	cc = NewConsistencyCheck(context.Background(), original, nil, testResource, 5, "test")
+	if err := cc.CheckState(buildTestState(t, id, "set by server")); err == nil || !err.Retryable {
+		t.Errorf("expected a retryable mismatch on description")
+	}
+}
+
+func TestUnitConsistencyCheckMaxAttempts(t *testing.T) {
+	const id = "cc-max-attempts"
+	original := buildTestState(t, id, "set by user")
+	cc := NewConsistencyCheck(context.Background(), original, nil, testResource, 5, "test", WithMaxAttempts(2), WithCheckDelay(time.Millisecond))
+	defer DeleteConsistencyCheck(original.Id())
+
+	if err := cc.CheckState(buildTestState(t, id, "set by server")); err == nil || !err.Retryable {
+		t.Fatalf("expected the first mismatch to be retryable")
+	}
+
+	err := cc.CheckState(buildTestState(t, id, "set by server"))
+	if err == nil || err.Retryable {
+		t.Fatalf("expected a non-retryable error once max attempts were reached")
+	}
+	for _, expected := range []string{"after 2 attempts", "description", "set by user", "set by server"} {
+		if !strings.Contains(err.Err.Error(), expected) {
+			t.Errorf("expected error to contain %q, got: %v", expected, err.Err)
+		}
+	}
+}
+
+func TestUnitConsistencyCheckDisabled(t *testing.T) {
+	const id = "cc-disabled"
+	SetDisabled(true)
+	defer SetDisabled(false)
+
+	cc := NewConsistencyCheck(context.Background(), buildTestState(t, id, "set by user"), nil, testResource, 5, "test")
+	defer DeleteConsistencyCheck(id)
+	if err := cc.CheckState(buildTestState(t, id, "set by server")); err != nil {
+		t.Errorf("expected a disabled check not to report a mismatch, got: %v", err.Err)
+	}
+}
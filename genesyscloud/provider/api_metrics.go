@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"regexp"
+	"sync"
+	featureToggles "terraform-provider-genesyscloud/genesyscloud/util/feature_toggles"
+	"time"
+)
+
+const (
+	apiMetricsFilePath = "api-metrics.log.json"
+
+	// The metrics file is rewritten at most this often, or sooner once apiMetricsFlushThreshold new responses are counted
+	apiMetricsFlushInterval  = 5 * time.Second
+	apiMetricsFlushThreshold = 100
+)
+
+var guidPathSegment = regexp.MustCompile(`[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}`)
+
+// apiCallMetrics counts SDK responses by method, path and status code across every client config created by the provider.
+// Object IDs are replaced in paths so that calls against the same endpoint are counted together.
+// Counting is done in memory on the response path, and the file is written in the background.
+type apiCallMetrics struct {
+	mu        sync.Mutex
+	counts    map[string]int
+	unflushed int
+	filePath  string
+	flushNow  chan struct{}
+	stop      chan struct{}
+	stopped   chan struct{}
+	stopOnce  sync.Once
+}
+
+var (
+	apiMetrics     *apiCallMetrics
+	apiMetricsOnce sync.Once
+)
+
+func newApiCallMetrics(filePath string) *apiCallMetrics {
+	return &apiCallMetrics{
+		counts:   make(map[string]int),
+		filePath: filePath,
+		flushNow: make(chan struct{}, 1),
+		stop:     make(chan struct{}),
+		stopped:  make(chan struct{}),
+	}
+}
+
+// getApiCallMetrics returns the metrics shared across the provider, or nil if the API metrics toggle is not set
+func getApiCallMetrics() *apiCallMetrics {
+	apiMetricsOnce.Do(func() {
+		if featureToggles.APIMetricsToggleExists() {
+			log.Printf("%s is set, writing API call counts to %s", featureToggles.APIMetricsToggleName(), apiMetricsFilePath)
+			apiMetrics = newApiCallMetrics(apiMetricsFilePath)
+			go apiMetrics.flushPeriodically(apiMetricsFlushInterval)
+		}
+	})
+	return apiMetrics
+}
+
+// FlushApiMetrics stops the background writer and writes the final counts for the run. It is called once the plugin
+// has stopped serving, since responses counted after the last periodic flush would otherwise be lost on exit.
+func FlushApiMetrics() {
+	if apiMetrics != nil {
+		apiMetrics.close()
+	}
+}
+
+// record counts a response. Once enough responses have been counted since the last flush, the background flush is
+// woken early rather than waiting for the next interval.
+func (m *apiCallMetrics) record(method string, path string, statusCode int) {
+	key := apiMetricsKey(method, path, statusCode)
+
+	m.mu.Lock()
+	m.counts[key]++
+	m.unflushed++
+	flush := m.unflushed >= apiMetricsFlushThreshold
+	m.mu.Unlock()
+
+	if flush {
+		select {
+		case m.flushNow <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (m *apiCallMetrics) flushPeriodically(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	defer close(m.stopped)
+	for {
+		select {
+		case <-ticker.C:
+		case <-m.flushNow:
+		case <-m.stop:
+			m.flush()
+			return
+		}
+		m.flush()
+	}
+}
+
+// close stops the background writer and waits for it to write the final counts
+func (m *apiCallMetrics) close() {
+	m.stopOnce.Do(func() {
+		close(m.stop)
+	})
+	<-m.stopped
+}
+
+// flush rewrites the metrics file with the totals for the run so far, if any response was counted since the last flush
+func (m *apiCallMetrics) flush() {
+	m.mu.Lock()
+	if m.unflushed == 0 {
+		m.mu.Unlock()
+		return
+	}
+	counts := make(map[string]int, len(m.counts))
+	for key, count := range m.counts {
+		counts[key] = count
+	}
+	m.unflushed = 0
+	m.mu.Unlock()
+
+	jsonData, err := json.MarshalIndent(counts, "", "  ")
+	if err != nil {
+		log.Printf("Error marshaling API metrics: %v", err)
+		return
+	}
+
+	if err := os.WriteFile(m.filePath, jsonData, os.ModePerm); err != nil {
+		log.Printf("Error writing file %s: %v", m.filePath, err)
+	}
+}
+
+func apiMetricsKey(method string, path string, statusCode int) string {
+	return fmt.Sprintf("%s %s %d", method, guidPathSegment.ReplaceAllString(path, "{id}"), statusCode)
+}
@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestUnitApiCallMetrics(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "api-metrics.log.json")
+	metrics := newApiCallMetrics(filePath)
+
+	metrics.record(http.MethodGet, "/api/v2/routing/queues/0a3e6a2f-8b1c-4d5e-9f60-1a2b3c4d5e6f", http.StatusOK)
+	metrics.record(http.MethodGet, "/api/v2/routing/queues/7b8c9d0e-1f2a-4b3c-8d4e-5f6a7b8c9d0e", http.StatusOK)
+	metrics.record(http.MethodGet, "/api/v2/routing/queues/7b8c9d0e-1f2a-4b3c-8d4e-5f6a7b8c9d0e", http.StatusNotFound)
+	metrics.record(http.MethodPost, "/api/v2/routing/queues", http.StatusOK)
+
+	if _, err := os.Stat(filePath); !os.IsNotExist(err) {
+		t.Errorf("expected recording a response not to write the metrics file")
+	}
+	metrics.flush()
+
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		t.Fatalf("expected metrics file to be written: %v", err)
+	}
+
+	var counts map[string]int
+	if err := json.Unmarshal(data, &counts); err != nil {
+		t.Fatalf("expected metrics file to contain JSON: %v", err)
+	}
+
+	expected := map[string]int{
+		"GET /api/v2/routing/queues/{id} 200": 2,
+		"GET /api/v2/routing/queues/{id} 404": 1,
+		"POST /api/v2/routing/queues 200":     1,
+	}
+	if len(counts) != len(expected) {
+		t.Errorf("expected %d metric keys, got %v", len(expected), counts)
+	}
+	for key, count := range expected {
+		if counts[key] != count {
+			t.Errorf("expected %d for %q, got %d", count, key, counts[key])
+		}
+	}
+}
+
+func TestUnitApiCallMetricsFlushThreshold(t *testing.T) {
+	metrics := newApiCallMetrics(filepath.Join(t.TempDir(), "api-metrics.log.json"))
+
+	for i := 0; i < apiMetricsFlushThreshold-1; i++ {
+		metrics.record(http.MethodGet, "/api/v2/routing/queues", http.StatusOK)
+	}
+	if len(metrics.flushNow) != 0 {
+		t.Errorf("expected no early flush below the threshold")
+	}
+
+	metrics.record(http.MethodGet, "/api/v2/routing/queues", http.StatusOK)
+	metrics.record(http.MethodGet, "/api/v2/routing/queues", http.StatusOK)
+	if len(metrics.flushNow) != 1 {
+		t.Errorf("expected a single early flush to be requested once the threshold was reached")
+	}
+
+	metrics.flush()
+	if metrics.unflushed != 0 {
+		t.Errorf("expected the unflushed count to be reset, got %d", metrics.unflushed)
+	}
+}
+
+func TestUnitApiCallMetricsFlushOnClose(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "api-metrics.log.json")
+	metrics := newApiCallMetrics(filePath)
+	go metrics.flushPeriodically(time.Hour)
+
+	// A short run counts fewer responses than the threshold and ends before the first interval
+	metrics.record(http.MethodPost, "/api/v2/routing/queues", http.StatusOK)
+	metrics.record(http.MethodGet, "/api/v2/routing/queues/0a3e6a2f-8b1c-4d5e-9f60-1a2b3c4d5e6f", http.StatusOK)
+	metrics.close()
+
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		t.Fatalf("expected metrics file to be written on close: %v", err)
+	}
+
+	var counts map[string]int
+	if err := json.Unmarshal(data, &counts); err != nil {
+		t.Fatalf("expected metrics file to contain JSON: %v", err)
+	}
+	if counts["POST /api/v2/routing/queues 200"] != 1 || counts["GET /api/v2/routing/queues/{id} 200"] != 1 {
+		t.Errorf("expected the counts recorded before close, got %v", counts)
+	}
+
+	// Closing again must not block or panic
+	metrics.close()
+}
@@ -9,6 +9,7 @@ import (
 	"path/filepath"
 	"regexp"
 	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -111,6 +112,19 @@ func New(version string, providerResources map[string]*schema.Resource, provider
 					Description:  "Max number of OAuth tokens in the token pool. Can be set with the `GENESYSCLOUD_TOKEN_POOL_SIZE` environment variable.",
 					ValidateFunc: validation.IntBetween(1, 20),
 				},
+				"disable_consistency_checker": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("GENESYSCLOUD_DISABLE_CONSISTENCY_CHECKER", false),
+					Description: "Skips the post-apply consistency check that re-reads resources until they match the configuration. This speeds up applies against fast orgs, at the cost of not retrying reads of state that is still propagating. Can be set with the `GENESYSCLOUD_DISABLE_CONSISTENCY_CHECKER` environment variable.",
+				},
+				"requests_per_minute": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					DefaultFunc:  schema.EnvDefaultFunc("GENESYSCLOUD_REQUESTS_PER_MINUTE", 0),
+					Description:  "Max number of API requests per minute issued by the provider across all resources. 0 means no limit. Can be set with the `GENESYSCLOUD_REQUESTS_PER_MINUTE` environment variable.",
+					ValidateFunc: validation.IntAtLeast(0),
+				},
 				"proxy": {
 					Type:     schema.TypeSet,
 					Optional: true,
@@ -175,6 +189,8 @@ type ProviderMeta struct {
 
 func configure(version string) schema.ConfigureContextFunc {
 	return func(context context.Context, data *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		consistency_checker.SetDisabled(data.Get("disable_consistency_checker").(bool))
+
 		// Initialize a single client if we have an access token
 		accessToken := data.Get("access_token").(string)
 		if accessToken != "" {
@@ -255,16 +271,24 @@ func InitClientConfig(data *schema.ResourceData, version string, config *platfor
 	setupProxy(data, config)
 
 	config.AddDefaultHeader("User-Agent", "GC Terraform Provider/"+version)
+	limiter := getRequestRateLimiter(data.Get("requests_per_minute").(int))
+	metrics := getApiCallMetrics()
 	config.RetryConfiguration = &platformclientv2.RetryConfiguration{
 		RetryWaitMin: time.Second * 1,
 		RetryWaitMax: time.Second * 30,
 		RetryMax:     20,
 		RequestLogHook: func(request *http.Request, count int) {
+			if limiter != nil && request != nil {
+				limiter.wait(request.Context())
+			}
 			if count > 0 && request != nil {
 				log.Printf("Retry #%d for %s %s", count, request.Method, request.URL)
 			}
 		},
 		ResponseLogHook: func(response *http.Response) {
+			if metrics != nil && response.Request != nil {
+				metrics.record(response.Request.Method, response.Request.URL.Path, response.StatusCode)
+			}
 			if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
 				log.Printf("Response %s for request:%s %s", response.Status, response.Request.Method, response.Request.URL)
 			}
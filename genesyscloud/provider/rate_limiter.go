@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// requestRateLimiter is a token bucket shared by every SDK client config created by the provider.
+// It is consulted from the SDK request hook, so each HTTP attempt made by any resource proxy takes a token.
+type requestRateLimiter struct {
+	mu       sync.Mutex
+	rate     float64 // tokens per second
+	capacity float64
+	tokens   float64
+	last     time.Time
+}
+
+var (
+	requestLimiters      = make(map[int]*requestRateLimiter)
+	requestLimitersMutex sync.Mutex
+)
+
+// newRequestRateLimiter returns a limiter allowing requestsPerMinute requests, with bursts of up to one second's worth
+func newRequestRateLimiter(requestsPerMinute int) *requestRateLimiter {
+	rate := float64(requestsPerMinute) / 60
+	capacity := rate
+	if capacity < 1 {
+		capacity = 1
+	}
+	return &requestRateLimiter{
+		rate:     rate,
+		capacity: capacity,
+		tokens:   capacity,
+		last:     time.Now(),
+	}
+}
+
+// getRequestRateLimiter returns the limiter shared by every client configured with requestsPerMinute, or nil if
+// requests are not limited. Limiters are keyed by rate so that a provider configured again in the same process,
+// as the acceptance tests do, gets the rate it asked for.
+func getRequestRateLimiter(requestsPerMinute int) *requestRateLimiter {
+	if requestsPerMinute <= 0 {
+		return nil
+	}
+
+	requestLimitersMutex.Lock()
+	defer requestLimitersMutex.Unlock()
+
+	limiter, ok := requestLimiters[requestsPerMinute]
+	if !ok {
+		limiter = newRequestRateLimiter(requestsPerMinute)
+		requestLimiters[requestsPerMinute] = limiter
+	}
+	return limiter
+}
+
+// reserve takes a token and returns how long the caller must wait before it may be used
+func (l *requestRateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.tokens += now.Sub(l.last).Seconds() * l.rate
+	if l.tokens > l.capacity {
+		l.tokens = l.capacity
+	}
+	l.last = now
+
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// wait blocks until a token is available or ctx is done. A cancelled request stops waiting straight away, so that
+// interrupting a run does not hang until every queued request's slot comes round.
+func (l *requestRateLimiter) wait(ctx context.Context) {
+	wait := l.reserve()
+	if wait <= 0 {
+		return
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+	case <-ctx.Done():
+	}
+}
@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestUnitRequestRateLimiter(t *testing.T) {
+	// 120 requests per minute allows a burst of 2 and then one request every 500ms
+	limiter := newRequestRateLimiter(120)
+
+	for i := 0; i < 2; i++ {
+		if wait := limiter.reserve(); wait != 0 {
+			t.Errorf("expected request %d to be within the burst, got wait %v", i, wait)
+		}
+	}
+
+	wait := limiter.reserve()
+	if wait < 400*time.Millisecond || wait > 500*time.Millisecond {
+		t.Errorf("expected the third request to wait about 500ms, got %v", wait)
+	}
+
+	wait = limiter.reserve()
+	if wait < 900*time.Millisecond || wait > time.Second {
+		t.Errorf("expected the fourth request to wait about 1s, got %v", wait)
+	}
+}
+
+func TestUnitGetRequestRateLimiterPerConfigure(t *testing.T) {
+	// The first configure in the process does not limit requests
+	if limiter := getRequestRateLimiter(0); limiter != nil {
+		t.Errorf("expected no limiter when requests_per_minute is 0")
+	}
+
+	// A later configure with its own value is still limited, at its own rate
+	first := getRequestRateLimiter(120)
+	if first == nil || first.rate != 2 {
+		t.Fatalf("expected a limiter at 2 requests per second, got %+v", first)
+	}
+	second := getRequestRateLimiter(60)
+	if second == nil || second == first || second.rate != 1 {
+		t.Fatalf("expected a separate limiter at 1 request per second, got %+v", second)
+	}
+
+	if getRequestRateLimiter(120) != first {
+		t.Errorf("expected clients configured with the same rate to share a limiter")
+	}
+}
+
+func TestUnitRequestRateLimiterWaitCancelled(t *testing.T) {
+	// 1 request per minute leaves the second request waiting about a minute for its token
+	limiter := newRequestRateLimiter(1)
+	limiter.wait(context.Background())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		cancel()
+	}()
+
+	start := time.Now()
+	limiter.wait(ctx)
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("expected wait to return once the context was cancelled, took %v", elapsed)
+	}
+}
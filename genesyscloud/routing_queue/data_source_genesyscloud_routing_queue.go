@@ -51,35 +51,32 @@ func normalizeQueueName(queueName string) string {
 func hydrateRoutingQueueCacheFn(c *rc.DataSourceCache) error {
 	log.Printf("hydrating cache for data source genesyscloud_routing_queues")
 	routingApi := platformclientv2.NewRoutingApiWithConfig(c.ClientConfig)
-	const pageSize = 100
-	queues, _, getErr := routingApi.GetRoutingQueues(1, pageSize, "", "", nil, nil, nil, "", false)
 
+	queues, _, getErr := util.PaginateAll(getRoutingQueuesPageFn(routingApi, ""))
 	if getErr != nil {
-		return fmt.Errorf("failed to get page of skills: %v", getErr)
-	}
-	if queues.Entities == nil || len(*queues.Entities) == 0 {
-		return nil
-	}
-	for _, queue := range *queues.Entities {
-		c.Cache[normalizeQueueName(*queue.Name)] = *queue.Id
+		return fmt.Errorf("failed to get page of queues: %v", getErr)
 	}
 
-	for pageNum := 2; pageNum <= *queues.PageCount; pageNum++ {
+	// Add ids to cache
+	cacheQueueNames(c.Cache, make(map[string]bool), queues)
+	log.Printf("cache hydration completed for data source genesyscloud_routing_queues")
+	return nil
+}
 
-		queues, _, getErr := routingApi.GetRoutingQueues(pageNum, pageSize, "", "", nil, nil, nil, "", false)
-		if getErr != nil {
-			return fmt.Errorf("failed to get page of queues: %v", getErr)
-		}
-		if queues.Entities == nil || len(*queues.Entities) == 0 {
-			break
+// getRoutingQueuesPageFn returns a page fetcher for the routing queues, optionally filtered by name
+func getRoutingQueuesPageFn(routingApi *platformclientv2.RoutingApi, name string) util.PageFetchFunc[platformclientv2.Queue] {
+	const pageSize = 100
+	return func(pageNum int) (*[]platformclientv2.Queue, int, *platformclientv2.APIResponse, error) {
+		queues, resp, err := routingApi.GetRoutingQueues(pageNum, pageSize, "", name, nil, nil, nil, "", false)
+		if err != nil {
+			return nil, 0, resp, err
 		}
-		// Add ids to cache
-		for _, queue := range *queues.Entities {
-			c.Cache[normalizeQueueName(*queue.Name)] = *queue.Id
+		pageCount := 0
+		if queues.PageCount != nil {
+			pageCount = *queues.PageCount
 		}
+		return queues.Entities, pageCount, resp, nil
 	}
-	log.Printf("cache hydration completed for data source genesyscloud_routing_queues")
-	return nil
 }
 
 // Get queue by name.
@@ -87,26 +84,51 @@ func hydrateRoutingQueueCacheFn(c *rc.DataSourceCache) error {
 func getQueueByNameFn(c *rc.DataSourceCache, name string, ctx context.Context) (string, diag.Diagnostics) {
 	routingApi := platformclientv2.NewRoutingApiWithConfig(c.ClientConfig)
 	queueId := ""
-	const pageSize = 100
 	diag := util.WithRetries(ctx, 15*time.Second, func() *retry.RetryError {
-		for pageNum := 1; ; pageNum++ {
-			queues, resp, getErr := routingApi.GetRoutingQueues(pageNum, pageSize, "", name, nil, nil, nil, "", false)
-			if getErr != nil {
-				return retry.NonRetryableError(util.BuildWithRetriesApiDiagnosticError(resourceName, fmt.Sprintf("error requesting queue %s | error %s", name, getErr), resp))
-			}
-
-			if queues.Entities == nil || len(*queues.Entities) == 0 {
-				return retry.RetryableError(util.BuildWithRetriesApiDiagnosticError(resourceName, fmt.Sprintf("no routing queues found with name %s", name), resp))
-			}
-
-			for _, queue := range *queues.Entities {
-				if queue.Name != nil && normalizeQueueName(*queue.Name) == normalizeQueueName(name) {
-					queueId = *queue.Id
-					return nil
-				}
-			}
+		queues, resp, getErr := util.PaginateAll(getRoutingQueuesPageFn(routingApi, name))
+		if getErr != nil {
+			return retry.NonRetryableError(util.BuildWithRetriesApiDiagnosticError(resourceName, fmt.Sprintf("error requesting queue %s | error %s", name, getErr), resp))
+		}
+
+		matchingIds := findQueueIdsByName(queues, name)
+		switch len(matchingIds) {
+		case 0:
+			return retry.RetryableError(util.BuildWithRetriesApiDiagnosticError(resourceName, fmt.Sprintf("no routing queues found with name %s", name), resp))
+		case 1:
+			queueId = matchingIds[0]
+			return nil
+		default:
+			return retry.NonRetryableError(util.BuildWithRetriesApiDiagnosticError(resourceName, fmt.Sprintf("found %d routing queues named %s (%s). Queue names must be unique to be looked up by name", len(matchingIds), name, strings.Join(matchingIds, ", ")), resp))
 		}
 	})
 
 	return queueId, diag
 }
+
+// cacheQueueNames adds queues to the name to ID cache. Names shared by more than one queue are left out
+// so that a lookup falls back to getQueueByNameFn, which reports the ambiguity.
+func cacheQueueNames(cache map[string]string, duplicateNames map[string]bool, queues []platformclientv2.Queue) {
+	for _, queue := range queues {
+		if queue.Name == nil || queue.Id == nil {
+			continue
+		}
+		key := normalizeQueueName(*queue.Name)
+		if _, exists := cache[key]; exists || duplicateNames[key] {
+			delete(cache, key)
+			duplicateNames[key] = true
+			continue
+		}
+		cache[key] = *queue.Id
+	}
+}
+
+// findQueueIdsByName returns the IDs of the queues whose name matches, ignoring case
+func findQueueIdsByName(queues []platformclientv2.Queue, name string) []string {
+	var ids []string
+	for _, queue := range queues {
+		if queue.Name != nil && queue.Id != nil && normalizeQueueName(*queue.Name) == normalizeQueueName(name) {
+			ids = append(ids, *queue.Id)
+		}
+	}
+	return ids
+}
@@ -3,8 +3,11 @@ package routing_queue
 import (
 	"context"
 	"fmt"
+	"net/http"
 	rc "terraform-provider-genesyscloud/genesyscloud/resource_cache"
+	"terraform-provider-genesyscloud/genesyscloud/util"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
 )
 
@@ -21,6 +24,7 @@ var internalProxy *RoutingQueueProxy
 type getAllRoutingQueuesFunc func(ctx context.Context, p *RoutingQueueProxy) (*[]platformclientv2.Queue, *platformclientv2.APIResponse, error)
 type getRoutingQueueByIdFunc func(ctx context.Context, p *RoutingQueueProxy, queueId string) (*platformclientv2.Queue, *platformclientv2.APIResponse, error)
 type getRoutingQueueWrapupCodeIdsFunc func(ctx context.Context, p *RoutingQueueProxy, queueId string) ([]string, *platformclientv2.APIResponse, error)
+type getRoutingQueueMembersFunc func(ctx context.Context, p *RoutingQueueProxy, queueId string, memberBy string) ([]platformclientv2.Queuemember, diag.Diagnostics)
 
 // RoutingQueueProxy contains all the methods that call genesys cloud APIs.
 type RoutingQueueProxy struct {
@@ -29,6 +33,7 @@ type RoutingQueueProxy struct {
 	getAllRoutingQueuesAttr          getAllRoutingQueuesFunc
 	getRoutingQueueByIdAttr          getRoutingQueueByIdFunc
 	getRoutingQueueWrapupCodeIdsAttr getRoutingQueueWrapupCodeIdsFunc
+	getRoutingQueueMembersAttr       getRoutingQueueMembersFunc
 	RoutingQueueCache                rc.CacheInterface[platformclientv2.Queue]
 }
 
@@ -43,6 +48,7 @@ func newRoutingQueuesProxy(clientConfig *platformclientv2.Configuration) *Routin
 		getAllRoutingQueuesAttr:          getAllRoutingQueuesFn,
 		getRoutingQueueByIdAttr:          getRoutingQueueByIdFn,
 		getRoutingQueueWrapupCodeIdsAttr: getRoutingQueueWrapupCodeIdsFn,
+		getRoutingQueueMembersAttr:       getRoutingQueueMembersFn,
 		RoutingQueueCache:                routingQueueCache,
 	}
 }
@@ -71,6 +77,11 @@ func (p *RoutingQueueProxy) getRoutingQueueWrapupCodeIds(ctx context.Context, qu
 	return p.getRoutingQueueWrapupCodeIdsAttr(ctx, p, queueId)
 }
 
+// getRoutingQueueMembers returns the members of a routing queue, either its users or its groups depending on memberBy
+func (p *RoutingQueueProxy) getRoutingQueueMembers(ctx context.Context, queueId string, memberBy string) ([]platformclientv2.Queuemember, diag.Diagnostics) {
+	return p.getRoutingQueueMembersAttr(ctx, p, queueId, memberBy)
+}
+
 // getAllRoutingQueuesFn is the implementation for retrieving all routing queues in Genesys Cloud
 func getAllRoutingQueuesFn(ctx context.Context, p *RoutingQueueProxy) (*[]platformclientv2.Queue, *platformclientv2.APIResponse, error) {
 	var allQueues []platformclientv2.Queue
@@ -125,12 +136,21 @@ func getRoutingQueueByIdFn(ctx context.Context, p *RoutingQueueProxy, queueId st
 
 	queue, resp, err := p.routingApi.GetRoutingQueue(queueId)
 	if err != nil {
-		return nil, resp, fmt.Errorf("failed to retrieve routing queue by id %s: %s", queueId, err)
+		return nil, resp, buildGetRoutingQueueError(queueId, resp, err)
 	}
 
 	return queue, resp, nil
 }
 
+// buildGetRoutingQueueError returns a NotFoundError when the queue is missing or has been deleted
+func buildGetRoutingQueueError(queueId string, resp *platformclientv2.APIResponse, err error) error {
+	err = fmt.Errorf("failed to retrieve routing queue by id %s: %w", queueId, err)
+	if resp != nil && (resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusGone) {
+		return util.NewNotFoundError(resourceName, queueId, err)
+	}
+	return err
+}
+
 func getRoutingQueueWrapupCodeIdsFn(ctx context.Context, p *RoutingQueueProxy, queueId string) ([]string, *platformclientv2.APIResponse, error) {
 	var codeIds []string
 	const pageSize = 100
@@ -162,3 +182,8 @@ func getRoutingQueueWrapupCodeIdsFn(ctx context.Context, p *RoutingQueueProxy, q
 
 	return codeIds, resp, nil
 }
+
+// getRoutingQueueMembersFn is the implementation for retrieving the members of a routing queue in Genesys Cloud
+func getRoutingQueueMembersFn(ctx context.Context, p *RoutingQueueProxy, queueId string, memberBy string) ([]platformclientv2.Queuemember, diag.Diagnostics) {
+	return getRoutingQueueMembers(queueId, memberBy, p.clientConfig)
+}
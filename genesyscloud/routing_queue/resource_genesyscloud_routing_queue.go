@@ -3,6 +3,7 @@ package routing_queue
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
@@ -30,6 +31,16 @@ import (
 
 var bullseyeExpansionTypeTimeout = "TIMEOUT_SECONDS"
 
+// maxCreateDependencyAttempts caps how many times a create that 404s on a referenced object is attempted
+const maxCreateDependencyAttempts = 5
+
+// A read that never becomes consistent would otherwise retry until the read timeout, and be restarted after it.
+// Members and wrapup codes are written through separate APIs, so checks are spaced out to let them settle.
+const (
+	queueConsistencyMaxAttempts = 20
+	queueConsistencyCheckDelay  = time.Second
+)
+
 func getAllRoutingQueues(ctx context.Context, clientConfig *platformclientv2.Configuration) (resourceExporter.ResourceIDMetaMap, diag.Diagnostics) {
 	resources := make(resourceExporter.ResourceIDMetaMap)
 	proxy := GetRoutingQueueProxy(clientConfig)
@@ -64,12 +75,13 @@ func createQueue(ctx context.Context, d *schema.ResourceData, meta interface{})
 	createQueue := platformclientv2.Createqueuerequest{
 		Name:                         platformclientv2.String(d.Get("name").(string)),
 		Description:                  platformclientv2.String(d.Get("description").(string)),
+		PeerId:                       resourcedata.GetNillableValue[string](d, "peer_id"),
 		MediaSettings:                buildSdkMediaSettings(d),
 		RoutingRules:                 buildSdkRoutingRules(d),
 		Bullseye:                     buildSdkBullseyeSettings(d),
 		AcwSettings:                  buildSdkAcwSettings(d),
 		AgentOwnedRouting:            constructAgentOwnedRouting(d),
-		SkillEvaluationMethod:        platformclientv2.String(d.Get("skill_evaluation_method").(string)),
+		SkillEvaluationMethod:        platformclientv2.String(strings.ToUpper(d.Get("skill_evaluation_method").(string))),
 		QueueFlow:                    util.BuildSdkDomainEntityRef(d, "queue_flow_id"),
 		EmailInQueueFlow:             util.BuildSdkDomainEntityRef(d, "email_in_queue_flow_id"),
 		MessageInQueueFlow:           util.BuildSdkDomainEntityRef(d, "message_in_queue_flow_id"),
@@ -83,6 +95,7 @@ func createQueue(ctx context.Context, d *schema.ResourceData, meta interface{})
 		EnableTranscription:          platformclientv2.Bool(d.Get("enable_transcription").(bool)),
 		SuppressInQueueCallRecording: platformclientv2.Bool(d.Get("suppress_in_queue_call_recording").(bool)),
 		EnableManualAssignment:       platformclientv2.Bool(d.Get("enable_manual_assignment").(bool)),
+		EnableAudioMonitoring:        resourcedata.GetNillableBool(d, "enable_audio_monitoring"),
 		DirectRouting:                buildSdkDirectRouting(d),
 		MemberGroups:                 &memberGroups,
 	}
@@ -110,7 +123,9 @@ func createQueue(ctx context.Context, d *schema.ResourceData, meta interface{})
 	if scoringMethod != "" {
 		createQueue.ScoringMethod = &scoringMethod
 	}
-	queue, resp, err := routingAPI.PostRoutingQueues(createQueue)
+	queue, resp, err := postQueueWithDependencyRetries(ctx, func() (*platformclientv2.Queue, *platformclientv2.APIResponse, error) {
+		return routingAPI.PostRoutingQueues(createQueue)
+	})
 	if err != nil {
 		log.Printf("error while trying to create queue: %s. Err %s", *createQueue.Name, err)
 		return util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Failed to create queue %s error: %s", *createQueue.Name, err), resp)
@@ -135,26 +150,66 @@ func createQueue(ctx context.Context, d *schema.ResourceData, meta interface{})
 	return readQueue(ctx, d, meta)
 }
 
+// postQueueWithDependencyRetries retries a queue create that 404s because a division, flow or prompt created earlier in
+// the same apply has not propagated yet. Attempts are capped so that a reference that will never resolve still fails.
+func postQueueWithDependencyRetries(ctx context.Context, post func() (*platformclientv2.Queue, *platformclientv2.APIResponse, error), opts ...util.RetryOption) (*platformclientv2.Queue, *platformclientv2.APIResponse, error) {
+	var (
+		queue *platformclientv2.Queue
+		resp  *platformclientv2.APIResponse
+		err   error
+	)
+
+	attempts := 0
+	diagErr := util.WithRetriesOpts(ctx, time.Minute, func() *retry.RetryError {
+		attempts++
+		queue, resp, err = post()
+		if err != nil {
+			// Only a plain 404 is retried. IsStatus404 also matches 408 and 410, and re-sending the create after a
+			// request timeout could duplicate a queue the server already created.
+			if resp != nil && resp.StatusCode == http.StatusNotFound && attempts < maxCreateDependencyAttempts {
+				log.Printf("Queue create returned %d, a referenced object may not have propagated yet. Attempt %d of %d", resp.StatusCode, attempts, maxCreateDependencyAttempts)
+				return retry.RetryableError(err)
+			}
+			return retry.NonRetryableError(err)
+		}
+		return nil
+	}, opts...)
+	if diagErr != nil && err == nil {
+		err = fmt.Errorf("%v", diagErr)
+	}
+	return queue, resp, err
+}
+
 func readQueue(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
 	proxy := GetRoutingQueueProxy(sdkConfig)
-	cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, ResourceRoutingQueue(), constants.DefaultConsistencyChecks, resourceName)
+	cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, ResourceRoutingQueue(), constants.DefaultConsistencyChecks, resourceName,
+		consistency_checker.WithMaxAttempts(queueConsistencyMaxAttempts), consistency_checker.WithCheckDelay(queueConsistencyCheckDelay))
 
 	log.Printf("Reading queue %s", d.Id())
 	return util.WithRetriesForRead(ctx, d, func() *retry.RetryError {
 		currentQueue, resp, getErr := proxy.getRoutingQueueById(ctx, d.Id())
 		if getErr != nil {
-			if util.IsStatus404(resp) {
-				return retry.RetryableError(util.BuildWithRetriesApiDiagnosticError(resourceName, fmt.Sprintf("Failed to read queue %s | error: %s", d.Id(), getErr), resp))
+			diagErr := util.BuildWithRetriesApiDiagnosticError(resourceName, fmt.Sprintf("Failed to read queue %s | error: %s", d.Id(), getErr), resp)
+			if isRetryableQueueReadError(resp, getErr) {
+				return util.RetryableErrorWithResponse(resp, diagErr)
 			}
-			return retry.NonRetryableError(util.BuildWithRetriesApiDiagnosticError(resourceName, fmt.Sprintf("Failed to read queue %s | error: %s", d.Id(), getErr), resp))
+			return retry.NonRetryableError(diagErr)
 		}
 
 		resourcedata.SetNillableValue(d, "name", currentQueue.Name)
 		resourcedata.SetNillableValue(d, "description", currentQueue.Description)
+		resourcedata.SetNillableValue(d, "peer_id", currentQueue.PeerId)
+		resourcedata.SetNillableTime(d, "date_modified", currentQueue.DateModified)
+		resourcedata.SetNillableValue(d, "member_count", currentQueue.MemberCount)
 		resourcedata.SetNillableValue(d, "skill_evaluation_method", currentQueue.SkillEvaluationMethod)
 
 		resourcedata.SetNillableReferenceDivision(d, "division_id", currentQueue.Division)
+		if currentQueue.Division != nil {
+			resourcedata.SetNillableValue(d, "division_name", currentQueue.Division.Name)
+		} else {
+			_ = d.Set("division_name", nil)
+		}
 
 		_ = d.Set("acw_wrapup_prompt", nil)
 		_ = d.Set("acw_timeout_ms", nil)
@@ -198,6 +253,7 @@ func readQueue(ctx context.Context, d *schema.ResourceData, meta interface{}) di
 		resourcedata.SetNillableValue(d, "enable_transcription", currentQueue.EnableTranscription)
 		resourcedata.SetNillableValue(d, "suppress_in_queue_call_recording", currentQueue.SuppressInQueueCallRecording)
 		resourcedata.SetNillableValue(d, "enable_manual_assignment", currentQueue.EnableManualAssignment)
+		resourcedata.SetNillableValue(d, "enable_audio_monitoring", currentQueue.EnableAudioMonitoring)
 		resourcedata.SetNillableValue(d, "calling_party_name", currentQueue.CallingPartyName)
 		resourcedata.SetNillableValue(d, "calling_party_number", currentQueue.CallingPartyNumber)
 		resourcedata.SetNillableValue(d, "scoring_method", currentQueue.ScoringMethod)
@@ -222,7 +278,7 @@ func readQueue(ctx context.Context, d *schema.ResourceData, meta interface{}) di
 		}
 		_ = d.Set("wrapup_codes", wrapupCodes)
 
-		members, err := flattenQueueMembers(d.Id(), "user", sdkConfig)
+		members, err := flattenQueueMembers(ctx, d.Id(), "user", proxy)
 		if err != nil {
 			return retry.NonRetryableError(fmt.Errorf("%v", err))
 		}
@@ -258,6 +314,13 @@ func readQueue(ctx context.Context, d *schema.ResourceData, meta interface{}) di
 	})
 }
 
+// isRetryableQueueReadError reports whether a failed queue read may succeed if tried again. A queue that is not found
+// may not be visible yet after being created, and timeouts, rate limiting and gateway errors are transient.
+func isRetryableQueueReadError(resp *platformclientv2.APIResponse, err error) bool {
+	var notFoundErr *util.NotFoundError
+	return errors.As(err, &notFoundErr) || util.IsStatus429(resp) || util.IsGatewayError(resp)
+}
+
 func updateQueue(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
 	routingAPI := platformclientv2.NewRoutingApiWithConfig(sdkConfig)
@@ -271,12 +334,13 @@ func updateQueue(ctx context.Context, d *schema.ResourceData, meta interface{})
 	updateQueue := platformclientv2.Queuerequest{
 		Name:                         platformclientv2.String(d.Get("name").(string)),
 		Description:                  platformclientv2.String(d.Get("description").(string)),
+		PeerId:                       resourcedata.GetNillableValue[string](d, "peer_id"),
 		MediaSettings:                buildSdkMediaSettings(d),
 		RoutingRules:                 buildSdkRoutingRules(d),
 		Bullseye:                     buildSdkBullseyeSettings(d),
 		AcwSettings:                  buildSdkAcwSettings(d),
 		AgentOwnedRouting:            constructAgentOwnedRouting(d),
-		SkillEvaluationMethod:        platformclientv2.String(d.Get("skill_evaluation_method").(string)),
+		SkillEvaluationMethod:        platformclientv2.String(strings.ToUpper(d.Get("skill_evaluation_method").(string))),
 		QueueFlow:                    util.BuildSdkDomainEntityRef(d, "queue_flow_id"),
 		EmailInQueueFlow:             util.BuildSdkDomainEntityRef(d, "email_in_queue_flow_id"),
 		MessageInQueueFlow:           util.BuildSdkDomainEntityRef(d, "message_in_queue_flow_id"),
@@ -290,6 +354,7 @@ func updateQueue(ctx context.Context, d *schema.ResourceData, meta interface{})
 		EnableTranscription:          platformclientv2.Bool(d.Get("enable_transcription").(bool)),
 		SuppressInQueueCallRecording: platformclientv2.Bool(d.Get("suppress_in_queue_call_recording").(bool)),
 		EnableManualAssignment:       platformclientv2.Bool(d.Get("enable_manual_assignment").(bool)),
+		EnableAudioMonitoring:        resourcedata.GetNillableBool(d, "enable_audio_monitoring"),
 		DirectRouting:                buildSdkDirectRouting(d),
 		MemberGroups:                 &memberGroups,
 	}
@@ -375,36 +440,70 @@ func deleteQueue(ctx context.Context, d *schema.ResourceData, meta interface{})
 
 func buildSdkMediaSettings(d *schema.ResourceData) *platformclientv2.Queuemediasettings {
 	queueMediaSettings := &platformclientv2.Queuemediasettings{}
+	rawConfig := d.GetRawConfig()
 
 	mediaSettingsCall := d.Get("media_settings_call").([]interface{})
 	if mediaSettingsCall != nil && len(mediaSettingsCall) > 0 {
+		removeUnconfiguredAlertTones(rawConfig, "media_settings_call", mediaSettingsCall)
 		queueMediaSettings.Call = buildSdkMediaSetting(mediaSettingsCall)
 	}
 
 	mediaSettingsCallback := d.Get("media_settings_callback").([]interface{})
 	if mediaSettingsCallback != nil && len(mediaSettingsCallback) > 0 {
+		removeUnconfiguredAlertTones(rawConfig, "media_settings_callback", mediaSettingsCallback)
 		queueMediaSettings.Callback = buildSdkMediaSettingCallback(mediaSettingsCallback)
 	}
 
 	mediaSettingsChat := d.Get("media_settings_chat").([]interface{})
 	if mediaSettingsChat != nil && len(mediaSettingsChat) > 0 {
+		removeUnconfiguredAlertTones(rawConfig, "media_settings_chat", mediaSettingsChat)
 		queueMediaSettings.Chat = buildSdkMediaSetting(mediaSettingsChat)
 	}
 
 	mediaSettingsEmail := d.Get("media_settings_email").([]interface{})
 	log.Printf("The media settings email #%v", mediaSettingsEmail)
 	if mediaSettingsEmail != nil && len(mediaSettingsEmail) > 0 {
+		removeUnconfiguredAlertTones(rawConfig, "media_settings_email", mediaSettingsEmail)
 		queueMediaSettings.Email = buildSdkMediaSetting(mediaSettingsEmail)
 	}
 
 	mediaSettingsMessage := d.Get("media_settings_message").([]interface{})
 	if mediaSettingsMessage != nil && len(mediaSettingsMessage) > 0 {
+		removeUnconfiguredAlertTones(rawConfig, "media_settings_message", mediaSettingsMessage)
 		queueMediaSettings.Message = buildSdkMediaSetting(mediaSettingsMessage)
 	}
 
 	return queueMediaSettings
 }
 
+// removeUnconfiguredAlertTones drops the alert tone durations that are not set in the configuration of a media settings
+// block. d.Get reports an unset float as 0, so the raw config is the only way to tell an explicit 0 from an unset value.
+func removeUnconfiguredAlertTones(rawConfig cty.Value, blockKey string, settings []interface{}) {
+	settingsMap := settings[0].(map[string]interface{})
+	for _, key := range []string{"auto_answer_alert_tone_seconds", "manual_answer_alert_tone_seconds"} {
+		if !isMediaSettingConfigured(rawConfig, blockKey, key) {
+			delete(settingsMap, key)
+		}
+	}
+}
+
+// isMediaSettingConfigured reports whether key is set in the first element of the media settings block blockKey
+func isMediaSettingConfigured(rawConfig cty.Value, blockKey string, key string) bool {
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return false
+	}
+	block := rawConfig.GetAttr(blockKey)
+	if block.IsNull() || !block.IsKnown() || block.LengthInt() == 0 {
+		return false
+	}
+	settings := block.Index(cty.NumberIntVal(0))
+	if settings.IsNull() || !settings.IsKnown() {
+		return false
+	}
+	value := settings.GetAttr(key)
+	return !value.IsNull()
+}
+
 func constructAgentOwnedRouting(d *schema.ResourceData) *platformclientv2.Agentownedrouting {
 	if agentOwnedRouting, ok := d.Get("agent_owned_routing").([]interface{}); ok {
 		if agentOwnedRouting != nil && len(agentOwnedRouting) > 0 {
@@ -427,14 +526,25 @@ func buildSdkMediaSetting(settings []interface{}) *platformclientv2.Mediasetting
 	settingsMap := settings[0].(map[string]interface{})
 
 	return &platformclientv2.Mediasettings{
-		AlertingTimeoutSeconds: platformclientv2.Int(settingsMap["alerting_timeout_sec"].(int)),
-		EnableAutoAnswer:       platformclientv2.Bool(settingsMap["enable_auto_answer"].(bool)),
+		AlertingTimeoutSeconds:       platformclientv2.Int(settingsMap["alerting_timeout_sec"].(int)),
+		EnableAutoAnswer:             platformclientv2.Bool(settingsMap["enable_auto_answer"].(bool)),
+		AutoAnswerAlertToneSeconds:   buildSdkAlertToneSeconds(settingsMap, "auto_answer_alert_tone_seconds"),
+		ManualAnswerAlertToneSeconds: buildSdkAlertToneSeconds(settingsMap, "manual_answer_alert_tone_seconds"),
 		ServiceLevel: &platformclientv2.Servicelevel{
 			Percentage: platformclientv2.Float64(settingsMap["service_level_percentage"].(float64)),
 			DurationMs: platformclientv2.Int(settingsMap["service_level_duration_ms"].(int)),
 		},
 	}
 }
+
+// buildSdkAlertToneSeconds returns nil for an unset alert tone duration so that the API default is kept
+func buildSdkAlertToneSeconds(settingsMap map[string]interface{}, key string) *float64 {
+	if seconds, ok := settingsMap[key].(float64); ok {
+		return &seconds
+	}
+	return nil
+}
+
 func buildSdkMediaSettingCallback(settings []interface{}) *platformclientv2.Callbackmediasettings {
 	settingsMap := settings[0].(map[string]interface{})
 
@@ -444,10 +554,12 @@ func buildSdkMediaSettingCallback(settings []interface{}) *platformclientv2.Call
 			Percentage: platformclientv2.Float64(settingsMap["service_level_percentage"].(float64)),
 			DurationMs: platformclientv2.Int(settingsMap["service_level_duration_ms"].(int)),
 		},
-		EnableAutoAnswer:     platformclientv2.Bool(settingsMap["enable_auto_answer"].(bool)),
-		AutoEndDelaySeconds:  platformclientv2.Int(settingsMap["auto_end_delay_seconds"].(int)),
-		AutoDialDelaySeconds: platformclientv2.Int(settingsMap["auto_dial_delay_seconds"].(int)),
-		EnableAutoDialAndEnd: platformclientv2.Bool(settingsMap["enable_auto_dial_and_end"].(bool)),
+		EnableAutoAnswer:             platformclientv2.Bool(settingsMap["enable_auto_answer"].(bool)),
+		AutoEndDelaySeconds:          platformclientv2.Int(settingsMap["auto_end_delay_seconds"].(int)),
+		AutoDialDelaySeconds:         platformclientv2.Int(settingsMap["auto_dial_delay_seconds"].(int)),
+		EnableAutoDialAndEnd:         platformclientv2.Bool(settingsMap["enable_auto_dial_and_end"].(bool)),
+		AutoAnswerAlertToneSeconds:   buildSdkAlertToneSeconds(settingsMap, "auto_answer_alert_tone_seconds"),
+		ManualAnswerAlertToneSeconds: buildSdkAlertToneSeconds(settingsMap, "manual_answer_alert_tone_seconds"),
 	}
 }
 
@@ -462,28 +574,36 @@ func flattenAgentOwnedRouting(settings *platformclientv2.Agentownedrouting) []in
 }
 
 func flattenMediaSetting(settings *platformclientv2.Mediasettings) []interface{} {
-	settingsMap := make(map[string]interface{})
+	return resourcedata.FlattenSubResource(settings, func(settings *platformclientv2.Mediasettings) map[string]interface{} {
+		settingsMap := make(map[string]interface{})
 
-	settingsMap["alerting_timeout_sec"] = *settings.AlertingTimeoutSeconds
-	resourcedata.SetMapValueIfNotNil(settingsMap, "enable_auto_answer", settings.EnableAutoAnswer)
-	settingsMap["service_level_percentage"] = *settings.ServiceLevel.Percentage
-	settingsMap["service_level_duration_ms"] = *settings.ServiceLevel.DurationMs
+		settingsMap["alerting_timeout_sec"] = *settings.AlertingTimeoutSeconds
+		resourcedata.SetMapValueIfNotNil(settingsMap, "enable_auto_answer", settings.EnableAutoAnswer)
+		settingsMap["service_level_percentage"] = *settings.ServiceLevel.Percentage
+		settingsMap["service_level_duration_ms"] = *settings.ServiceLevel.DurationMs
+		resourcedata.SetMapValueIfNotNil(settingsMap, "auto_answer_alert_tone_seconds", settings.AutoAnswerAlertToneSeconds)
+		resourcedata.SetMapValueIfNotNil(settingsMap, "manual_answer_alert_tone_seconds", settings.ManualAnswerAlertToneSeconds)
 
-	return []interface{}{settingsMap}
+		return settingsMap
+	})
 }
 
 func flattenMediaSettingCallback(settings *platformclientv2.Callbackmediasettings) []interface{} {
-	settingsMap := make(map[string]interface{})
-
-	settingsMap["alerting_timeout_sec"] = *settings.AlertingTimeoutSeconds
-	settingsMap["service_level_percentage"] = *settings.ServiceLevel.Percentage
-	settingsMap["service_level_duration_ms"] = *settings.ServiceLevel.DurationMs
-	resourcedata.SetMapValueIfNotNil(settingsMap, "enable_auto_answer", settings.EnableAutoAnswer)
-	resourcedata.SetMapValueIfNotNil(settingsMap, "enable_auto_dial_and_end", settings.EnableAutoDialAndEnd)
-	settingsMap["auto_end_delay_seconds"] = *settings.AutoEndDelaySeconds
-	settingsMap["auto_dial_delay_seconds"] = *settings.AutoDialDelaySeconds
-
-	return []interface{}{settingsMap}
+	return resourcedata.FlattenSubResource(settings, func(settings *platformclientv2.Callbackmediasettings) map[string]interface{} {
+		settingsMap := make(map[string]interface{})
+
+		settingsMap["alerting_timeout_sec"] = *settings.AlertingTimeoutSeconds
+		settingsMap["service_level_percentage"] = *settings.ServiceLevel.Percentage
+		settingsMap["service_level_duration_ms"] = *settings.ServiceLevel.DurationMs
+		resourcedata.SetMapValueIfNotNil(settingsMap, "enable_auto_answer", settings.EnableAutoAnswer)
+		resourcedata.SetMapValueIfNotNil(settingsMap, "enable_auto_dial_and_end", settings.EnableAutoDialAndEnd)
+		resourcedata.SetMapValueIfNotNil(settingsMap, "auto_answer_alert_tone_seconds", settings.AutoAnswerAlertToneSeconds)
+		resourcedata.SetMapValueIfNotNil(settingsMap, "manual_answer_alert_tone_seconds", settings.ManualAnswerAlertToneSeconds)
+		settingsMap["auto_end_delay_seconds"] = *settings.AutoEndDelaySeconds
+		settingsMap["auto_dial_delay_seconds"] = *settings.AutoDialDelaySeconds
+
+		return settingsMap
+	})
 }
 
 func buildMemberGroupList(d *schema.ResourceData, groupKey string, groupType string) *[]platformclientv2.Membergroup {
@@ -1062,7 +1182,7 @@ func updateQueueMembers(d *schema.ResourceData, sdkConfig *platformclientv2.Conf
 		usersToRemove := lists.SliceDifference(oldUserIds, newUserIds)
 		err := updateMembersInChunks(d.Id(), usersToRemove, true, sdkConfig)
 		if err != nil {
-			return err
+			return append(err, setQueueMembersFromServer(d, sdkConfig)...)
 		}
 	}
 
@@ -1070,7 +1190,7 @@ func updateQueueMembers(d *schema.ResourceData, sdkConfig *platformclientv2.Conf
 		usersToAdd := lists.SliceDifference(newUserIds, oldUserIds)
 		err := updateMembersInChunks(d.Id(), usersToAdd, false, sdkConfig)
 		if err != nil {
-			return err
+			return append(err, setQueueMembersFromServer(d, sdkConfig)...)
 		}
 	}
 
@@ -1137,32 +1257,64 @@ func verifyUserIsNotGroupMemberOfQueue(queueId, userId string, members []platfor
 	return nil
 }
 
+// setQueueMembersFromServer sets members to the queue's actual user members after a partially applied update, so that
+// the state saved with the error shows which users were added or removed and the next plan corrects the rest.
+func setQueueMembersFromServer(d *schema.ResourceData, sdkConfig *platformclientv2.Configuration) diag.Diagnostics {
+	members, err := flattenQueueMembers(context.Background(), d.Id(), "user", GetRoutingQueueProxy(sdkConfig))
+	if err != nil {
+		return err
+	}
+	_ = d.Set("members", members)
+	return nil
+}
+
 func updateMembersInChunks(queueID string, membersToUpdate []string, remove bool, sdkConfig *platformclientv2.Configuration) diag.Diagnostics {
 	api := platformclientv2.NewRoutingApiWithConfig(sdkConfig)
-	// API restricts member adds/removes to 100 per call
+	return postMembersInChunks(queueID, membersToUpdate, func(chunk []platformclientv2.Writableentity) (*platformclientv2.APIResponse, error) {
+		return api.PostRoutingQueueMembers(queueID, chunk, remove)
+	})
+}
+
+// postMembersInChunks adds or removes members through post, which the API restricts to 100 users per call.
+// Chunks are applied in order, so if one fails the users in the earlier chunks have already been updated and are
+// reported alongside the users in the failed chunk.
+func postMembersInChunks(queueID string, membersToUpdate []string, post func([]platformclientv2.Writableentity) (*platformclientv2.APIResponse, error)) diag.Diagnostics {
+	if len(membersToUpdate) == 0 {
+		return nil
+	}
 	// Generic call to prepare chunks for the Update. Takes in three args
 	// 1. MemberstoUpdate 2. The Entity prepare func for the update 3. Chunk Size
-	if len(membersToUpdate) > 0 {
-		chunks := chunksProcess.ChunkItems(membersToUpdate, platformWritableEntityFunc, 100)
-		// Closure to process the chunks
-		chunkProcessor := func(chunk []platformclientv2.Writableentity) diag.Diagnostics {
-			resp, err := api.PostRoutingQueueMembers(queueID, chunk, remove)
-			if err != nil {
-				return util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Failed to update members in queue %s error: %s", queueID, err), resp)
-			}
-			return nil
+	chunks := chunksProcess.ChunkItems(membersToUpdate, platformWritableEntityFunc, 100)
+	var updatedIds []string
+	// Closure to process the chunks
+	chunkProcessor := func(chunk []platformclientv2.Writableentity) diag.Diagnostics {
+		resp, err := post(chunk)
+		if err != nil {
+			// The call fails as a whole, so not every user in the failed batch was necessarily rejected
+			return util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Failed to update members in queue %s after updating %d of %d members. Users updated: [%s]. Users in the failed batch: [%s] error: %s",
+				queueID, len(updatedIds), len(membersToUpdate), strings.Join(updatedIds, ", "), strings.Join(writableEntityIds(chunk), ", "), err), resp)
 		}
-		// Generic Function call which takes in the chunks and the processing function
-		return chunksProcess.ProcessChunks(chunks, chunkProcessor)
+		updatedIds = append(updatedIds, writableEntityIds(chunk)...)
+		return nil
 	}
-	return nil
-
+	// Generic Function call which takes in the chunks and the processing function
+	return chunksProcess.ProcessChunks(chunks, chunkProcessor)
 }
 
 func platformWritableEntityFunc(val string) platformclientv2.Writableentity {
 	return platformclientv2.Writableentity{Id: &val}
 }
 
+func writableEntityIds(entities []platformclientv2.Writableentity) []string {
+	ids := make([]string, 0, len(entities))
+	for _, entity := range entities {
+		if entity.Id != nil {
+			ids = append(ids, *entity.Id)
+		}
+	}
+	return ids
+}
+
 func updateQueueUserRingNum(queueID string, userID string, ringNum int, sdkConfig *platformclientv2.Configuration) diag.Diagnostics {
 	api := platformclientv2.NewRoutingApiWithConfig(sdkConfig)
 	resp, err := api.PatchRoutingQueueMember(queueID, userID, platformclientv2.Queuemember{
@@ -1252,8 +1404,8 @@ func sdkGetRoutingQueueMembers(queueID, memberBy string, pageNumber, pageSize in
 	return successPayload, response, err
 }
 
-func flattenQueueMembers(queueID string, memberBy string, sdkConfig *platformclientv2.Configuration) (*schema.Set, diag.Diagnostics) {
-	members, err := getRoutingQueueMembers(queueID, memberBy, sdkConfig)
+func flattenQueueMembers(ctx context.Context, queueID string, memberBy string, proxy *RoutingQueueProxy) (*schema.Set, diag.Diagnostics) {
+	members, err := proxy.getRoutingQueueMembers(ctx, queueID, memberBy)
 	if err != nil {
 		return nil, err
 	}
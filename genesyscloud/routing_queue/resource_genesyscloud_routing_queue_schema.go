@@ -4,6 +4,7 @@ import (
 	"terraform-provider-genesyscloud/genesyscloud/provider"
 	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
 	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
+	"terraform-provider-genesyscloud/genesyscloud/util"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -62,6 +63,20 @@ var (
 				Required:     true,
 				ValidateFunc: validation.IntAtLeast(7),
 			},
+			"auto_answer_alert_tone_seconds": {
+				Description:  "How long to play the alerting tone for an auto-answer interaction.",
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.FloatAtLeast(0),
+			},
+			"manual_answer_alert_tone_seconds": {
+				Description:  "How long to play the alerting tone for a manual-answer interaction.",
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.FloatAtLeast(0),
+			},
 			"auto_end_delay_seconds": {
 				Description: "Auto End Delay Seconds.",
 				Type:        schema.TypeInt,
@@ -182,11 +197,32 @@ func ResourceRoutingQueue() *schema.Resource {
 				Optional:    true,
 				Computed:    true,
 			},
+			"division_name": {
+				Description: "The name of the division to which this queue belongs.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
 			"description": {
 				Description: "Queue description.",
 				Type:        schema.TypeString,
 				Optional:    true,
 			},
+			"peer_id": {
+				Description: "The ID of an associated external queue. Changing the peer ID forces a new queue to be created.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"date_modified": {
+				Description: "The date the queue was last modified, including changes made outside of Terraform.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"member_count": {
+				Description: "The total number of members in the queue, including members added outside of Terraform.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
 			"media_settings_call": {
 				Description: "Call media settings.",
 				Type:        schema.TypeList,
@@ -354,11 +390,12 @@ func ResourceRoutingQueue() *schema.Resource {
 				ValidateFunc: validation.IntBetween(0, 86400000),
 			},
 			"skill_evaluation_method": {
-				Description:  "The skill evaluation method to use when routing conversations (NONE | BEST | ALL).",
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "ALL",
-				ValidateFunc: validation.StringInSlice([]string{"NONE", "BEST", "ALL"}, false),
+				Description:      "The skill evaluation method to use when routing conversations (NONE | BEST | ALL).",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "ALL",
+				ValidateFunc:     validation.StringInSlice([]string{"NONE", "BEST", "ALL"}, true),
+				DiffSuppressFunc: util.CaseInsensitiveDiffSuppress,
 			},
 			"queue_flow_id": {
 				Description: "The in-queue flow ID to use for call conversations waiting in queue.",
@@ -409,6 +446,12 @@ func ResourceRoutingQueue() *schema.Resource {
 				Optional:    true,
 				Default:     false,
 			},
+			"enable_audio_monitoring": {
+				Description: "Indicates whether audio monitoring is enabled for this queue. If not set, the API default is used.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
 			"calling_party_name": {
 				Description: "The name to use for caller identification for outbound calls from this queue.",
 				Type:        schema.TypeString,
@@ -529,7 +572,8 @@ func RoutingQueueExporter() *resourceExporter.ResourceExporter {
 			"outbound_email_address": {"route_id"},
 			"members":                {"user_id"},
 		},
-		AllowZeroValues: []string{"bullseye_rings.expansion_timeout_seconds"},
+		ExcludedAttributes: []string{"date_modified", "division_name", "member_count"},
+		AllowZeroValues:    []string{"bullseye_rings.expansion_timeout_seconds"},
 		CustomAttributeResolver: map[string]*resourceExporter.RefAttrCustomResolver{
 			"bullseye_rings.member_groups.member_group_id":           {ResolverFunc: resourceExporter.MemberGroupsResolver},
 			"conditional_group_routing_rules.groups.member_group_id": {ResolverFunc: resourceExporter.MemberGroupsResolver},
@@ -539,7 +583,7 @@ func RoutingQueueExporter() *resourceExporter.ResourceExporter {
 
 func DataSourceRoutingQueue() *schema.Resource {
 	return &schema.Resource{
-		Description:        "Data source for Genesys Cloud Routing Queues. Select a queue by name.",
+		Description:        "Data source for Genesys Cloud Routing Queues. Select a queue by name. If more than one queue has the name, the data source fails and lists their IDs instead of selecting the first match, so a duplicated name must be made unique or the queue referenced by ID.",
 		ReadWithoutTimeout: provider.ReadWithPooledClient(dataSourceRoutingQueueRead),
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -113,6 +113,9 @@ func TestAccResourceRoutingQueueBasic(t *testing.T) {
 					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "enable_manual_assignment", util.FalseValue),
 					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "enable_transcription", util.FalseValue),
 					provider.TestDefaultHomeDivision("genesyscloud_routing_queue."+queueResource1),
+					resource.TestCheckResourceAttrSet("genesyscloud_routing_queue."+queueResource1, "member_count"),
+					resource.TestCheckResourceAttrSet("genesyscloud_routing_queue."+queueResource1, "date_modified"),
+					resource.TestCheckResourceAttrSet("genesyscloud_routing_queue."+queueResource1, "division_name"),
 					validateMediaSettings(queueResource1, "media_settings_call", alertTimeout1, util.FalseValue, slPercent1, slDuration1),
 					validateMediaSettings(queueResource1, "media_settings_callback", alertTimeout1, util.FalseValue, slPercent1, slDuration1),
 					validateMediaSettings(queueResource1, "media_settings_chat", alertTimeout1, util.FalseValue, slPercent1, slDuration1),
@@ -1196,11 +1199,75 @@ func TestAccResourceRoutingQueueDirectRoutingNoBackup(t *testing.T) {
 	})
 }
 
+func TestAccResourceRoutingQueueAudioMonitoring(t *testing.T) {
+	var (
+		queueResource1 = "test-queue-audio-monitoring"
+		queueName1     = "Terraform Test Queue-" + uuid.NewString()
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { util.TestAccPreCheck(t) },
+		ProviderFactories: provider.GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				// Create
+				Config: generateRoutingQueueResourceBasic(queueResource1, queueName1, "enable_audio_monitoring = true"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "enable_audio_monitoring", util.TrueValue),
+				),
+			},
+			{
+				// Update
+				Config: generateRoutingQueueResourceBasic(queueResource1, queueName1, "enable_audio_monitoring = false"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "enable_audio_monitoring", util.FalseValue),
+				),
+			},
+			{
+				// Import/Read
+				ResourceName:      "genesyscloud_routing_queue." + queueResource1,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+		CheckDestroy: testVerifyQueuesDestroyed,
+	})
+}
+
 // TestAccResourceRoutingQueueMembersOutsideOfConfig
 // Creates a queue and a user, and then adds the user to that queue outside Terraform.
 // On the next apply, we expect an empty plan and therefore no errors (achieved through 'members' being a computed field)
 // Although members should not be a computed field, it was always computed in the past. As a result, some CX as Code users got used
 // to the behaviour described above, so we don't want to break that behaviour.
+func TestAccResourceRoutingQueuePeerId(t *testing.T) {
+	var (
+		queueResource1 = "test-queue-peer-id"
+		queueName1     = "Terraform Test Queue-" + uuid.NewString()
+		peerId         = uuid.NewString()
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { util.TestAccPreCheck(t) },
+		ProviderFactories: provider.GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				// Create
+				Config: generateRoutingQueueResourceBasic(queueResource1, queueName1, "peer_id = "+strconv.Quote(peerId)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "peer_id", peerId),
+				),
+			},
+			{
+				// Import/Read
+				ResourceName:      "genesyscloud_routing_queue." + queueResource1,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+		CheckDestroy: testVerifyQueuesDestroyed,
+	})
+}
+
 func TestAccResourceRoutingQueueMembersOutsideOfConfig(t *testing.T) {
 	var (
 		userResourceId  = "user"
@@ -0,0 +1,260 @@
+package routing_queue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitPostQueueWithDependencyRetries(t *testing.T) {
+	queueId := uuid.NewString()
+
+	// The referenced flow becomes visible on the third attempt
+	attempts := 0
+	post := func() (*platformclientv2.Queue, *platformclientv2.APIResponse, error) {
+		attempts++
+		if attempts < 3 {
+			return nil, &platformclientv2.APIResponse{StatusCode: http.StatusNotFound}, fmt.Errorf("flow not found")
+		}
+		return &platformclientv2.Queue{Id: &queueId}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+
+	queue, resp, err := postQueueWithDependencyRetries(context.Background(), post, util.WithBackoff(util.ConstantBackoff(time.Millisecond)))
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, queueId, *queue.Id)
+	assert.Equal(t, 3, attempts)
+}
+
+func TestUnitPostQueueWithDependencyRetriesCapsAttempts(t *testing.T) {
+	attempts := 0
+	post := func() (*platformclientv2.Queue, *platformclientv2.APIResponse, error) {
+		attempts++
+		return nil, &platformclientv2.APIResponse{StatusCode: http.StatusNotFound}, fmt.Errorf("flow not found")
+	}
+
+	_, resp, err := postQueueWithDependencyRetries(context.Background(), post, util.WithBackoff(util.ConstantBackoff(time.Millisecond)))
+	assert.NotNil(t, err)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	assert.Equal(t, maxCreateDependencyAttempts, attempts)
+
+	// Other errors are not retried. A request timeout in particular may have created the queue already.
+	for _, statusCode := range []int{http.StatusBadRequest, http.StatusRequestTimeout, http.StatusGone} {
+		attempts = 0
+		post = func() (*platformclientv2.Queue, *platformclientv2.APIResponse, error) {
+			attempts++
+			return nil, &platformclientv2.APIResponse{StatusCode: statusCode}, fmt.Errorf("create failed with %d", statusCode)
+		}
+
+		_, _, err = postQueueWithDependencyRetries(context.Background(), post, util.WithBackoff(util.ConstantBackoff(time.Millisecond)))
+		assert.NotNil(t, err)
+		assert.Equal(t, 1, attempts, "status %d should not be retried", statusCode)
+	}
+}
+
+func TestUnitMediaSettingAlertToneSeconds(t *testing.T) {
+	// d.Get reports the unset manual alert tone as 0, like the explicit one
+	settings := []interface{}{map[string]interface{}{
+		"alerting_timeout_sec":             20,
+		"enable_auto_answer":               true,
+		"auto_answer_alert_tone_seconds":   float64(0),
+		"manual_answer_alert_tone_seconds": float64(0),
+		"service_level_percentage":         0.8,
+		"service_level_duration_ms":        20000,
+	}}
+	callSettings := cty.ObjectVal(map[string]cty.Value{
+		"auto_answer_alert_tone_seconds":   cty.NumberFloatVal(0),
+		"manual_answer_alert_tone_seconds": cty.NullVal(cty.Number),
+	})
+	rawConfig := cty.ObjectVal(map[string]cty.Value{
+		"media_settings_call":  cty.ListVal([]cty.Value{callSettings}),
+		"media_settings_email": cty.NullVal(cty.List(callSettings.Type())),
+	})
+
+	removeUnconfiguredAlertTones(rawConfig, "media_settings_call", settings)
+	mediaSetting := buildSdkMediaSetting(settings)
+	if assert.NotNil(t, mediaSetting.AutoAnswerAlertToneSeconds, "an explicit 0 should be sent") {
+		assert.Equal(t, float64(0), *mediaSetting.AutoAnswerAlertToneSeconds)
+	}
+	assert.Nil(t, mediaSetting.ManualAnswerAlertToneSeconds, "an unset alert tone should be left to the API default")
+
+	assert.False(t, isMediaSettingConfigured(rawConfig, "media_settings_email", "auto_answer_alert_tone_seconds"))
+	assert.False(t, isMediaSettingConfigured(cty.NullVal(rawConfig.Type()), "media_settings_call", "auto_answer_alert_tone_seconds"))
+
+	// The API fills in its default on read
+	mediaSetting.ManualAnswerAlertToneSeconds = platformclientv2.Float64(5)
+	flattened := flattenMediaSetting(mediaSetting)[0].(map[string]interface{})
+	assert.Equal(t, float64(0), flattened["auto_answer_alert_tone_seconds"])
+	assert.Equal(t, float64(5), flattened["manual_answer_alert_tone_seconds"])
+
+	assert.Empty(t, flattenMediaSetting(nil))
+	assert.Empty(t, flattenMediaSettingCallback(nil))
+}
+
+func TestUnitQueueNameLookupWithDuplicates(t *testing.T) {
+	buildQueue := func(id string, name string) platformclientv2.Queue {
+		return platformclientv2.Queue{Id: platformclientv2.String(id), Name: platformclientv2.String(name)}
+	}
+
+	firstPage := []platformclientv2.Queue{buildQueue("id-1", "Support"), buildQueue("id-2", "Sales")}
+	secondPage := []platformclientv2.Queue{buildQueue("id-3", "support"), buildQueue("id-4", "Billing")}
+
+	cache := make(map[string]string)
+	duplicateNames := make(map[string]bool)
+	cacheQueueNames(cache, duplicateNames, firstPage)
+	cacheQueueNames(cache, duplicateNames, secondPage)
+
+	assert.Equal(t, map[string]string{"sales": "id-2", "billing": "id-4"}, cache, "duplicate names should be left out of the cache")
+	assert.Equal(t, []string{"id-1", "id-3"}, findQueueIdsByName(append(firstPage, secondPage...), "SUPPORT"))
+	assert.Equal(t, []string{"id-2"}, findQueueIdsByName(firstPage, "Sales"))
+	assert.Empty(t, findQueueIdsByName(firstPage, "Billing"))
+}
+
+func TestUnitReadQueueWithDuplicateName(t *testing.T) {
+	queueName := "Support"
+	firstId := uuid.NewString()
+	secondId := uuid.NewString()
+	queues := map[string]*platformclientv2.Queue{
+		firstId:  {Id: &firstId, Name: &queueName, Description: platformclientv2.String("first")},
+		secondId: {Id: &secondId, Name: &queueName, Description: platformclientv2.String("second")},
+	}
+
+	queueProxy := &RoutingQueueProxy{}
+	queueProxy.getAllRoutingQueuesAttr = func(ctx context.Context, p *RoutingQueueProxy) (*[]platformclientv2.Queue, *platformclientv2.APIResponse, error) {
+		t.Errorf("expected the queue to be read by ID without listing queues by name")
+		return nil, nil, nil
+	}
+	queueProxy.getRoutingQueueByIdAttr = func(ctx context.Context, p *RoutingQueueProxy, queueId string) (*platformclientv2.Queue, *platformclientv2.APIResponse, error) {
+		return queues[queueId], &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	queueProxy.getRoutingQueueWrapupCodeIdsAttr = func(ctx context.Context, p *RoutingQueueProxy, queueId string) ([]string, *platformclientv2.APIResponse, error) {
+		return nil, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	queueProxy.getRoutingQueueMembersAttr = func(ctx context.Context, p *RoutingQueueProxy, queueId string, memberBy string) ([]platformclientv2.Queuemember, diag.Diagnostics) {
+		return nil, nil
+	}
+
+	internalProxy = queueProxy
+	defer func() { internalProxy = nil }()
+
+	// The mocked queues do not echo back the schema defaults in the test state, which is not what this test checks
+	consistency_checker.SetDisabled(true)
+	defer consistency_checker.SetDisabled(false)
+
+	gc := &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+
+	// Both queues share a name, so each must still refresh to the queue with its own ID
+	for id, queue := range queues {
+		d := schema.TestResourceDataRaw(t, ResourceRoutingQueue().Schema, map[string]interface{}{})
+		d.SetId(id)
+
+		diagErr := readQueue(context.Background(), d, gc)
+		assert.False(t, diagErr.HasError(), "expected queue %s to be read: %v", id, diagErr)
+		assert.Equal(t, id, d.Id())
+		assert.Equal(t, queueName, d.Get("name"))
+		assert.Equal(t, *queue.Description, d.Get("description"))
+	}
+}
+
+func TestUnitSkillEvaluationMethodCaseInsensitive(t *testing.T) {
+	skillEvaluationMethod := ResourceRoutingQueue().Schema["skill_evaluation_method"]
+
+	_, errs := skillEvaluationMethod.ValidateFunc("best", "skill_evaluation_method")
+	assert.Empty(t, errs, "lower case values should be accepted")
+	_, errs = skillEvaluationMethod.ValidateFunc("most", "skill_evaluation_method")
+	assert.NotEmpty(t, errs)
+
+	assert.True(t, skillEvaluationMethod.DiffSuppressFunc("skill_evaluation_method", "BEST", "best", nil), "a case change should not produce a diff")
+	assert.False(t, skillEvaluationMethod.DiffSuppressFunc("skill_evaluation_method", "BEST", "all", nil))
+}
+
+func TestUnitBuildGetRoutingQueueError(t *testing.T) {
+	apiErr := fmt.Errorf("API Error: 404 - Not Found")
+
+	for _, statusCode := range []int{http.StatusNotFound, http.StatusGone} {
+		err := buildGetRoutingQueueError("queue-id", &platformclientv2.APIResponse{StatusCode: statusCode}, apiErr)
+		var notFoundErr *util.NotFoundError
+		if assert.True(t, errors.As(err, &notFoundErr), "status %d should be reported as not found", statusCode) {
+			assert.Equal(t, "queue-id", notFoundErr.Id)
+			assert.Contains(t, err.Error(), "API Error: 404")
+		}
+		assert.True(t, errors.Is(err, apiErr), "status %d should wrap the API error", statusCode)
+	}
+
+	for _, resp := range []*platformclientv2.APIResponse{{StatusCode: http.StatusRequestTimeout}, {StatusCode: http.StatusInternalServerError}, nil} {
+		var notFoundErr *util.NotFoundError
+		err := buildGetRoutingQueueError("queue-id", resp, apiErr)
+		assert.False(t, errors.As(err, &notFoundErr))
+		assert.True(t, errors.Is(err, apiErr))
+	}
+}
+
+func TestUnitIsRetryableQueueReadError(t *testing.T) {
+	apiErr := fmt.Errorf("API Error")
+	notFoundErr := buildGetRoutingQueueError("queue-id", &platformclientv2.APIResponse{StatusCode: http.StatusNotFound}, apiErr)
+	assert.True(t, isRetryableQueueReadError(&platformclientv2.APIResponse{StatusCode: http.StatusNotFound}, notFoundErr))
+
+	for _, statusCode := range []int{http.StatusRequestTimeout, http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout} {
+		resp := &platformclientv2.APIResponse{StatusCode: statusCode}
+		assert.True(t, isRetryableQueueReadError(resp, buildGetRoutingQueueError("queue-id", resp, apiErr)), "status %d should be retried", statusCode)
+	}
+
+	for _, resp := range []*platformclientv2.APIResponse{{StatusCode: http.StatusBadRequest}, {StatusCode: http.StatusInternalServerError}, nil} {
+		assert.False(t, isRetryableQueueReadError(resp, buildGetRoutingQueueError("queue-id", resp, apiErr)))
+	}
+}
+
+func TestUnitWritableEntityIds(t *testing.T) {
+	firstId := uuid.NewString()
+	secondId := uuid.NewString()
+	entities := []platformclientv2.Writableentity{{Id: &firstId}, {}, {Id: &secondId}}
+
+	assert.Equal(t, []string{firstId, secondId}, writableEntityIds(entities))
+	assert.Empty(t, writableEntityIds(nil))
+}
+
+func TestUnitPostMembersInChunksPartialFailure(t *testing.T) {
+	queueId := uuid.NewString()
+	memberIds := make([]string, 250)
+	for i := range memberIds {
+		memberIds[i] = uuid.NewString()
+	}
+
+	// The second chunk of 100 users fails, after the first chunk was applied
+	calls := 0
+	diagErr := postMembersInChunks(queueId, memberIds, func(chunk []platformclientv2.Writableentity) (*platformclientv2.APIResponse, error) {
+		calls++
+		if calls == 2 {
+			return &platformclientv2.APIResponse{StatusCode: http.StatusBadRequest}, fmt.Errorf("invalid user")
+		}
+		return &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	})
+
+	assert.Equal(t, 2, calls, "expected the remaining chunks to be skipped after the failure")
+	if assert.True(t, diagErr.HasError()) {
+		summary := diagErr[0].Summary
+		assert.Contains(t, summary, "after updating 100 of 250 members")
+		assert.Contains(t, summary, fmt.Sprintf("Users updated: [%s]", strings.Join(memberIds[:100], ", ")))
+		assert.Contains(t, summary, fmt.Sprintf("Users in the failed batch: [%s]", strings.Join(memberIds[100:200], ", ")))
+		assert.NotContains(t, summary, memberIds[200])
+	}
+
+	assert.Nil(t, postMembersInChunks(queueId, nil, func(chunk []platformclientv2.Writableentity) (*platformclientv2.APIResponse, error) {
+		t.Errorf("expected no call without members to update")
+		return nil, nil
+	}))
+}
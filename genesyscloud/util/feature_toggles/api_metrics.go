@@ -0,0 +1,15 @@
+package feature_toggles
+
+import "os"
+
+const apiMetricsEnvToggle = "ENABLE_API_METRICS"
+
+func APIMetricsToggleName() string {
+	return apiMetricsEnvToggle
+}
+
+func APIMetricsToggleExists() bool {
+	var exists bool
+	_, exists = os.LookupEnv(apiMetricsEnvToggle)
+	return exists
+}
@@ -111,9 +111,17 @@ func InterfaceListToStrings(interfaceList []interface{}) []string {
 	return strs
 }
 
+// BuildSdkStringList returns the strings in a set or list attribute, or nil if the attribute is unset or empty
+// so that the field is omitted from the request rather than clearing the server value
 func BuildSdkStringList(d *schema.ResourceData, attrName string) *[]string {
 	if val, ok := d.GetOk(attrName); ok {
-		return SetToStringList(val.(*schema.Set))
+		switch v := val.(type) {
+		case *schema.Set:
+			return SetToStringList(v)
+		case []interface{}:
+			strList := InterfaceListToStrings(v)
+			return &strList
+		}
 	}
 	return nil
 }
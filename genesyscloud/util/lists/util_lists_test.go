@@ -2,6 +2,8 @@ package lists
 
 import (
 	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 type AreEquivalentTestCase struct {
@@ -102,3 +104,37 @@ func TestRemove(t *testing.T) {
 		}
 	}
 }
+
+func TestBuildSdkStringList(t *testing.T) {
+	resourceSchema := map[string]*schema.Schema{
+		"set_attr":  {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+		"list_attr": {Type: schema.TypeList, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{})
+	if result := BuildSdkStringList(d, "set_attr"); result != nil {
+		t.Errorf("expected nil for an unset attribute, got %v", *result)
+	}
+
+	d = schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{
+		"set_attr":  []interface{}{},
+		"list_attr": []interface{}{},
+	})
+	if result := BuildSdkStringList(d, "set_attr"); result != nil {
+		t.Errorf("expected nil for an empty set, got %v", *result)
+	}
+	if result := BuildSdkStringList(d, "list_attr"); result != nil {
+		t.Errorf("expected nil for an empty list, got %v", *result)
+	}
+
+	d = schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{
+		"set_attr":  []interface{}{"a", "b"},
+		"list_attr": []interface{}{"c", "d"},
+	})
+	if result := BuildSdkStringList(d, "set_attr"); result == nil || !AreEquivalent(*result, []string{"a", "b"}) {
+		t.Errorf("expected set values [a b], got %v", result)
+	}
+	if result := BuildSdkStringList(d, "list_attr"); result == nil || !AreEquivalent(*result, []string{"c", "d"}) {
+		t.Errorf("expected list values [c d], got %v", result)
+	}
+}
@@ -109,6 +109,15 @@ func SetMapSchemaSetWithFuncIfNotNil[T any](targetMap map[string]interface{}, ke
 	}
 }
 
+// FlattenSubResource will convert a nested SDK struct into the single element list used for a nested block,
+// using {f} to build the element map. A nil {value} is flattened to an empty list.
+func FlattenSubResource[T any](value *T, f func(*T) map[string]interface{}) []interface{} {
+	if value == nil {
+		return []interface{}{}
+	}
+	return []interface{}{f(value)}
+}
+
 // Use these functions to read values for an object and set them on the schema
 
 // SetNillableReference will read the value of a reference property and set it on the schema
@@ -147,6 +156,15 @@ func SetNillableValue[T any](d *schema.ResourceData, key string, value *T) {
 	}
 }
 
+// SetNillableValueWithDefault will read a basic type and set it on the schema, falling back to {def} if {value} is nil
+func SetNillableValueWithDefault[T any](d *schema.ResourceData, key string, value *T, def T) {
+	if value != nil {
+		_ = d.Set(key, *value)
+	} else {
+		_ = d.Set(key, def)
+	}
+}
+
 // SetNillableValueWithInterfaceArrayWithFunc will set the value of {key} to an interface array using func {f} if {value} is not nil
 func SetNillableValueWithInterfaceArrayWithFunc[T any](d *schema.ResourceData, key string, value *T, f func(*T) []interface{}) {
 	if value != nil {
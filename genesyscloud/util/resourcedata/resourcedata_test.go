@@ -0,0 +1,72 @@
+package resourcedata
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var testResourceSchema = map[string]*schema.Schema{
+	"enabled": {Type: schema.TypeBool, Optional: true},
+	"name":    {Type: schema.TypeString, Optional: true},
+	"count":   {Type: schema.TypeInt, Optional: true},
+}
+
+func TestUnitSetNillableValueWithDefault(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, testResourceSchema, map[string]interface{}{})
+
+	// Nil pointer falls back to the default
+	SetNillableValueWithDefault[bool](d, "enabled", nil, true)
+	if v := d.Get("enabled").(bool); v != true {
+		t.Errorf("expected default value true for nil pointer, got %v", v)
+	}
+
+	// A set pointer takes precedence over the default
+	name := "queue"
+	SetNillableValueWithDefault(d, "name", &name, "default")
+	if v := d.Get("name").(string); v != name {
+		t.Errorf("expected %s, got %s", name, v)
+	}
+
+	// A pointer to the zero value is kept rather than replaced by the default
+	zero := 0
+	SetNillableValueWithDefault(d, "count", &zero, 10)
+	if v := d.Get("count").(int); v != 0 {
+		t.Errorf("expected zero value to be kept, got %d", v)
+	}
+	disabled := false
+	SetNillableValueWithDefault(d, "enabled", &disabled, true)
+	if v := d.Get("enabled").(bool); v != false {
+		t.Errorf("expected false to be kept, got %v", v)
+	}
+}
+
+func TestUnitFlattenSubResource(t *testing.T) {
+	type serviceLevel struct {
+		Percentage *float64
+		DurationMs *int
+	}
+	flattenServiceLevel := func(level *serviceLevel) map[string]interface{} {
+		levelMap := make(map[string]interface{})
+		SetMapValueIfNotNil(levelMap, "percentage", level.Percentage)
+		SetMapValueIfNotNil(levelMap, "duration_ms", level.DurationMs)
+		return levelMap
+	}
+
+	if flattened := FlattenSubResource[serviceLevel](nil, flattenServiceLevel); flattened == nil || len(flattened) != 0 {
+		t.Errorf("expected an empty list for a nil struct, got %v", flattened)
+	}
+
+	percentage := 0.8
+	flattened := FlattenSubResource(&serviceLevel{Percentage: &percentage}, flattenServiceLevel)
+	if len(flattened) != 1 {
+		t.Fatalf("expected a single element, got %d", len(flattened))
+	}
+	levelMap := flattened[0].(map[string]interface{})
+	if levelMap["percentage"] != percentage {
+		t.Errorf("expected percentage %v, got %v", percentage, levelMap["percentage"])
+	}
+	if _, ok := levelMap["duration_ms"]; ok {
+		t.Errorf("expected nil duration_ms to be omitted")
+	}
+}
@@ -142,3 +142,29 @@ func TestUnitTestAPIResponseWithRetriesDiagWithBadApiResponse(t *testing.T) {
 	assert.Equal(t, sumErrMsg, lines[0])
 	assert.Equal(t, targetResponse, lines[1])
 }
+
+func TestUnitTestAPIResponseDiagIncludesResponseBody(t *testing.T) {
+	request := &http.Request{
+		Method: "PUT",
+		URL:    &url.URL{Path: "/api/v2/routing/queues/1234"},
+	}
+	body := `{"message":"Invalid mediaSettings","code":"bad.request","status":400}`
+	apiResponse := &platformclientv2.APIResponse{
+		Response:      &http.Response{Request: request},
+		StatusCode:    http.StatusBadRequest,
+		RawBody:       []byte(body),
+		CorrelationID: "e03b48a1-7063-4ae2-921a-f64c8e02702b",
+	}
+
+	diag := BuildAPIDiagnosticError("genesyscloud_routing_queue", "Failed to update queue", apiResponse)
+	actualDiag := &detailedDiagnosticInfo{}
+	_ = json.Unmarshal([]byte(diag[0].Detail), actualDiag)
+	assert.Equal(t, body, actualDiag.ResponseBody)
+
+	apiResponse.RawBody = []byte(strings.Repeat("x", maxDiagnosticBodyLength*2))
+	diag = BuildAPIDiagnosticError("genesyscloud_routing_queue", "Failed to update queue", apiResponse)
+	actualDiag = &detailedDiagnosticInfo{}
+	_ = json.Unmarshal([]byte(diag[0].Detail), actualDiag)
+	assert.True(t, strings.HasSuffix(actualDiag.ResponseBody, "...(truncated)"))
+	assert.Equal(t, maxDiagnosticBodyLength+len("...(truncated)"), len(actualDiag.ResponseBody))
+}
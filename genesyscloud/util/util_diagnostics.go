@@ -16,8 +16,12 @@ type detailedDiagnosticInfo struct {
 	StatusCode    int    `json:"statusCode,omitempty"`
 	ErrorMessage  string `json:"errorMessage,omitempty"`
 	CorrelationID string `json:"correlationId,omitempty"`
+	ResponseBody  string `json:"responseBody,omitempty"`
 }
 
+// maxDiagnosticBodyLength caps how much of a response body is copied into a diagnostic
+const maxDiagnosticBodyLength = 500
+
 func convertResponseToWrapper(resourceName string, apiResponse *platformclientv2.APIResponse) *detailedDiagnosticInfo {
 	return &detailedDiagnosticInfo{
 		ResourceName:  resourceName,
@@ -26,7 +30,15 @@ func convertResponseToWrapper(resourceName string, apiResponse *platformclientv2
 		StatusCode:    apiResponse.StatusCode,
 		ErrorMessage:  apiResponse.ErrorMessage,
 		CorrelationID: apiResponse.CorrelationID,
+		ResponseBody:  truncateResponseBody(apiResponse.RawBody),
+	}
+}
+
+func truncateResponseBody(body []byte) string {
+	if len(body) > maxDiagnosticBodyLength {
+		return string(body[:maxDiagnosticBodyLength]) + "...(truncated)"
 	}
+	return string(body)
 }
 
 func BuildAPIDiagnosticError(resourceName string, summary string, apiResponse *platformclientv2.APIResponse) diag.Diagnostics {
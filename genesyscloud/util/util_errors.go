@@ -0,0 +1,29 @@
+package util
+
+import (
+	"fmt"
+)
+
+// NotFoundError is returned by proxy get methods when the requested object does not exist or has been deleted, so
+// that CRUD functions can detect a 404 or 410 with errors.As instead of inspecting the API response
+type NotFoundError struct {
+	ResourceType string
+	Id           string
+	Err          error
+}
+
+func NewNotFoundError(resourceType string, id string, err error) *NotFoundError {
+	return &NotFoundError{
+		ResourceType: resourceType,
+		Id:           id,
+		Err:          err,
+	}
+}
+
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("%s %s not found: %v", e.ResourceType, e.Id, e.Err)
+}
+
+func (e *NotFoundError) Unwrap() error {
+	return e.Err
+}
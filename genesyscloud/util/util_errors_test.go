@@ -0,0 +1,31 @@
+package util
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestUnitNotFoundError(t *testing.T) {
+	apiErr := fmt.Errorf("API Error: 404 - Not Found")
+	err := fmt.Errorf("failed to read queue: %w", NewNotFoundError("genesyscloud_routing_queue", "queue-id", apiErr))
+
+	var notFoundErr *NotFoundError
+	if !errors.As(err, &notFoundErr) {
+		t.Fatalf("expected a wrapped NotFoundError to be detected")
+	}
+	if notFoundErr.ResourceType != "genesyscloud_routing_queue" || notFoundErr.Id != "queue-id" {
+		t.Errorf("expected the resource type and ID to be available, got %v", notFoundErr)
+	}
+	if !errors.Is(err, apiErr) {
+		t.Errorf("expected the underlying API error to be unwrapped")
+	}
+	if !strings.Contains(err.Error(), "API Error: 404") {
+		t.Errorf("expected the API error text to be kept in the message, got %s", err.Error())
+	}
+
+	if errors.As(apiErr, &notFoundErr) {
+		t.Errorf("expected an error without a NotFoundError not to match")
+	}
+}
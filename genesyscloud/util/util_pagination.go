@@ -0,0 +1,37 @@
+package util
+
+import (
+	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
+)
+
+// PageFetchFunc retrieves a single page (starting at 1) and reports the entities on it along with the total page count
+type PageFetchFunc[T any] func(pageNum int) (entities *[]T, pageCount int, resp *platformclientv2.APIResponse, err error)
+
+// PaginateAll calls fetch for every page up to and including the page count reported by the first page, and
+// returns all entities in page order. Paging stops early if a page comes back empty.
+// The APIResponse of the last call made is returned so that callers can build diagnostics from it.
+func PaginateAll[T any](fetch PageFetchFunc[T]) ([]T, *platformclientv2.APIResponse, error) {
+	all := make([]T, 0)
+
+	entities, pageCount, resp, err := fetch(1)
+	if err != nil {
+		return nil, resp, err
+	}
+	if entities == nil || len(*entities) == 0 {
+		return all, resp, nil
+	}
+	all = append(all, *entities...)
+
+	for pageNum := 2; pageNum <= pageCount; pageNum++ {
+		entities, _, resp, err = fetch(pageNum)
+		if err != nil {
+			return nil, resp, err
+		}
+		if entities == nil || len(*entities) == 0 {
+			break
+		}
+		all = append(all, *entities...)
+	}
+
+	return all, resp, nil
+}
@@ -0,0 +1,81 @@
+package util
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
+)
+
+// buildPageFetcher serves the given pages and records which page numbers were requested
+func buildPageFetcher(pages [][]string, requested *[]int) PageFetchFunc[string] {
+	return func(pageNum int) (*[]string, int, *platformclientv2.APIResponse, error) {
+		*requested = append(*requested, pageNum)
+		if pageNum > len(pages) {
+			return &[]string{}, len(pages), nil, nil
+		}
+		page := pages[pageNum-1]
+		return &page, len(pages), nil, nil
+	}
+}
+
+func TestUnitPaginateAll(t *testing.T) {
+	type testCase struct {
+		name          string
+		pages         [][]string
+		expected      []string
+		expectedCalls int
+	}
+
+	testCases := []testCase{
+		{
+			name:          "empty",
+			pages:         [][]string{{}},
+			expected:      []string{},
+			expectedCalls: 1,
+		},
+		{
+			name:          "single page",
+			pages:         [][]string{{"a", "b"}},
+			expected:      []string{"a", "b"},
+			expectedCalls: 1,
+		},
+		{
+			name:          "multiple pages",
+			pages:         [][]string{{"a", "b"}, {"c", "d"}, {"e"}},
+			expected:      []string{"a", "b", "c", "d", "e"},
+			expectedCalls: 3,
+		},
+	}
+
+	for _, tc := range testCases {
+		var requested []int
+		all, _, err := PaginateAll(buildPageFetcher(tc.pages, &requested))
+		if err != nil {
+			t.Errorf("%s: expected no error, got %v", tc.name, err)
+		}
+		if !StrArrayEquals(all, tc.expected) {
+			t.Errorf("%s: expected %v, got %v", tc.name, tc.expected, all)
+		}
+		if len(requested) != tc.expectedCalls {
+			t.Errorf("%s: expected %d page requests, got %v", tc.name, tc.expectedCalls, requested)
+		}
+	}
+}
+
+func TestUnitPaginateAllError(t *testing.T) {
+	fetch := func(pageNum int) (*[]string, int, *platformclientv2.APIResponse, error) {
+		if pageNum == 2 {
+			return nil, 0, nil, fmt.Errorf("failed to get page %d", pageNum)
+		}
+		return &[]string{"a"}, 3, nil, nil
+	}
+
+	all, _, err := PaginateAll(fetch)
+	if err == nil {
+		t.Errorf("expected the page error to be returned")
+	}
+	if all != nil {
+		t.Errorf("expected no results when a page fails, got %v", all)
+	}
+}
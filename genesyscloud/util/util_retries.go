@@ -2,8 +2,11 @@ package util
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math/rand"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
@@ -17,33 +20,177 @@ import (
 	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
 )
 
-func WithRetries(ctx context.Context, timeout time.Duration, method func() *retry.RetryError) diag.Diagnostics {
-	err := diag.FromErr(retry.RetryContext(ctx, timeout, method))
-	if err != nil && strings.Contains(fmt.Sprintf("%v", err), "timeout while waiting for state to become") {
-		ctx, cancel := context.WithTimeout(context.Background(), timeout)
-		defer cancel()
-		return WithRetries(ctx, timeout, method)
+const (
+	defaultRetryBackoffBase = 500 * time.Millisecond
+	defaultRetryBackoffMax  = 10 * time.Second
+)
+
+// BackoffFunc returns how long to wait before the next attempt, given the number of attempts already made
+type BackoffFunc func(attempt int) time.Duration
+
+// RetryOption configures the behaviour of WithRetriesOpts
+type RetryOption func(*retryConfig)
+
+type retryConfig struct {
+	backoff BackoffFunc
+}
+
+// WithBackoff overrides the default exponential backoff used between retry attempts
+func WithBackoff(backoff BackoffFunc) RetryOption {
+	return func(c *retryConfig) {
+		c.backoff = backoff
 	}
-	return err
 }
 
-func WithRetriesForRead(ctx context.Context, d *schema.ResourceData, method func() *retry.RetryError) diag.Diagnostics {
-	return WithRetriesForReadCustomTimeout(ctx, 5*time.Minute, d, method)
+// ConstantBackoff waits the same interval between every attempt
+func ConstantBackoff(interval time.Duration) BackoffFunc {
+	return func(int) time.Duration {
+		return interval
+	}
+}
+
+// ExponentialBackoffWithJitter doubles the wait on every attempt starting from base, capped at max.
+// Half of each interval is randomised so that many resources retrying at once do not poll the API in lockstep.
+func ExponentialBackoffWithJitter(base time.Duration, max time.Duration) BackoffFunc {
+	return func(attempt int) time.Duration {
+		interval := max
+		if attempt < 32 && base<<attempt > 0 && base<<attempt < max {
+			interval = base << attempt
+		}
+		half := interval / 2
+		return half + time.Duration(rand.Int63n(int64(half)+1))
+	}
+}
+
+func newRetryConfig(opts ...RetryOption) *retryConfig {
+	c := &retryConfig{
+		backoff: ExponentialBackoffWithJitter(defaultRetryBackoffBase, defaultRetryBackoffMax),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// retryWithBackoff calls method until it succeeds, returns a non-retryable error or the timeout elapses.
+// On timeout the last retryable error is returned. If the parent context is cancelled the
+// retries stop straight away and a cancellation error is returned.
+func retryWithBackoff(parentCtx context.Context, timeout time.Duration, method func() *retry.RetryError, c *retryConfig) error {
+	ctx, cancel := context.WithTimeout(parentCtx, timeout)
+	defer cancel()
+
+	for attempt := 0; ; attempt++ {
+		if err := parentCtx.Err(); err != nil {
+			return fmt.Errorf("retries cancelled: %w", err)
+		}
+
+		retryErr := method()
+		if retryErr == nil {
+			return nil
+		}
+		if !retryErr.Retryable {
+			return retryErr.Err
+		}
+
+		wait := c.backoff(attempt)
+		var retryAfterErr *retryAfterError
+		if errors.As(retryErr.Err, &retryAfterErr) {
+			wait = retryAfterErr.wait
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			if err := parentCtx.Err(); err != nil {
+				return fmt.Errorf("retries cancelled: %w. Last error: %v", err, retryErr.Err)
+			}
+			return retryErr.Err
+		case <-timer.C:
+		}
+	}
+}
+
+// retryAfterError carries the wait advertised by the server alongside a retryable error
+type retryAfterError struct {
+	err  error
+	wait time.Duration
+}
+
+func (e *retryAfterError) Error() string {
+	return e.err.Error()
+}
+
+func (e *retryAfterError) Unwrap() error {
+	return e.err
+}
+
+// RetryableErrorWithResponse marks err as retryable. If resp is a 429 carrying a Retry-After header,
+// the retry helpers will wait for the advertised duration before the next attempt instead of using their backoff.
+func RetryableErrorWithResponse(resp *platformclientv2.APIResponse, err error) *retry.RetryError {
+	if wait := ParseRetryAfter(resp); wait > 0 && err != nil {
+		return retry.RetryableError(&retryAfterError{err: err, wait: wait})
+	}
+	return retry.RetryableError(err)
+}
+
+// ParseRetryAfter returns the wait advertised in the Retry-After header of a 429 response.
+// The header may hold either a number of seconds or an HTTP date. Zero is returned if there is nothing to honor.
+func ParseRetryAfter(resp *platformclientv2.APIResponse) time.Duration {
+	if resp == nil || resp.StatusCode != http.StatusTooManyRequests {
+		return 0
+	}
+
+	header := http.Header(resp.Header)
+	if len(header) == 0 && resp.Response != nil {
+		header = resp.Response.Header
+	}
+	value := strings.TrimSpace(header.Get("Retry-After"))
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds <= 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if retryAt, err := http.ParseTime(value); err == nil {
+		if wait := time.Until(retryAt); wait > 0 {
+			return wait
+		}
+	}
+	return 0
+}
+
+func WithRetries(ctx context.Context, timeout time.Duration, method func() *retry.RetryError) diag.Diagnostics {
+	return WithRetriesOpts(ctx, timeout, method)
+}
+
+// WithRetriesOpts behaves like WithRetries but allows the wait strategy between attempts to be configured.
+// With no options it uses exponential backoff with jitter.
+func WithRetriesOpts(ctx context.Context, timeout time.Duration, method func() *retry.RetryError, opts ...RetryOption) diag.Diagnostics {
+	return diag.FromErr(retryWithBackoff(ctx, timeout, method, newRetryConfig(opts...)))
 }
 
-func WithRetriesForReadCustomTimeout(ctx context.Context, timeout time.Duration, d *schema.ResourceData, method func() *retry.RetryError) diag.Diagnostics {
-	err := diag.FromErr(retry.RetryContext(ctx, timeout, method))
+func WithRetriesForRead(ctx context.Context, d *schema.ResourceData, method func() *retry.RetryError, opts ...RetryOption) diag.Diagnostics {
+	return WithRetriesForReadCustomTimeout(ctx, 5*time.Minute, d, method, opts...)
+}
+
+func WithRetriesForReadCustomTimeout(ctx context.Context, timeout time.Duration, d *schema.ResourceData, method func() *retry.RetryError, opts ...RetryOption) diag.Diagnostics {
+	err := diag.FromErr(retryWithBackoff(ctx, timeout, method, newRetryConfig(opts...)))
 	if err != nil {
 		if strings.Contains(fmt.Sprintf("%v", err), "API Error: 404") {
 			// Set ID empty if the object isn't found after the specified timeout
 			d.SetId("")
 		}
 		errStringLower := strings.ToLower(fmt.Sprintf("%v", err))
-		if strings.Contains(errStringLower, "timeout while waiting for state to become") ||
-			strings.Contains(errStringLower, "context deadline exceeded") {
+		if ctx.Err() == nil && (strings.Contains(errStringLower, "timeout while waiting for state to become") ||
+			strings.Contains(errStringLower, "context deadline exceeded")) {
 			ctx, cancel := context.WithTimeout(context.Background(), timeout)
 			defer cancel()
-			return WithRetriesForRead(ctx, d, method)
+			return WithRetriesForRead(ctx, d, method, opts...)
 		}
 		if d.Id() != "" {
 			consistency_checker.DeleteConsistencyCheck(d.Id())
@@ -63,9 +210,13 @@ func RetryWhen(shouldRetry checkResponseFunc, callSdk callSdkFunc, additionalCod
 		resp, sdkErr := callSdk()
 		if sdkErr != nil {
 			if resp != nil && shouldRetry(resp, additionalCodes...) {
-				// Wait a second and try again
+				// Wait for as long as the server asked, or a second otherwise, and try again
 				lastErr = sdkErr
-				time.Sleep(time.Second)
+				wait := ParseRetryAfter(resp)
+				if wait == 0 {
+					wait = time.Second
+				}
+				time.Sleep(wait)
 				continue
 			} else {
 				return sdkErr
@@ -153,6 +304,16 @@ func IsStatus409(resp *platformclientv2.APIResponse, additionalCodes ...int) boo
 	return false
 }
 
+func IsStatus409ByInt(respCode int, additionalCodes ...int) bool {
+	if respCode == http.StatusConflict ||
+		respCode == http.StatusRequestTimeout ||
+		IsAdditionalCode(respCode, additionalCodes...) {
+		return true
+	}
+
+	return false
+}
+
 func IsStatus412(resp *platformclientv2.APIResponse, additionalCodes ...int) bool {
 	if resp != nil {
 		if resp.StatusCode == http.StatusPreconditionFailed ||
@@ -173,3 +334,44 @@ func IsStatus412ByInt(respCode int, additionalCodes ...int) bool {
 
 	return false
 }
+
+func IsStatus429(resp *platformclientv2.APIResponse, additionalCodes ...int) bool {
+	if resp != nil {
+		return IsStatus429ByInt(resp.StatusCode, additionalCodes...)
+	}
+	return false
+}
+
+func IsStatus429ByInt(respCode int, additionalCodes ...int) bool {
+	if respCode == http.StatusTooManyRequests ||
+		respCode == http.StatusRequestTimeout ||
+		IsAdditionalCode(respCode, additionalCodes...) {
+		return true
+	}
+
+	return false
+}
+
+// IsGatewayError reports whether the response is a transient 502, 503 or 504 from the Genesys Cloud edge
+func IsGatewayError(resp *platformclientv2.APIResponse, additionalCodes ...int) bool {
+	if resp != nil {
+		return IsGatewayErrorByInt(resp.StatusCode, additionalCodes...)
+	}
+	return false
+}
+
+func IsGatewayErrorByInt(respCode int, additionalCodes ...int) bool {
+	if respCode == http.StatusBadGateway ||
+		respCode == http.StatusServiceUnavailable ||
+		respCode == http.StatusGatewayTimeout ||
+		IsAdditionalCode(respCode, additionalCodes...) {
+		return true
+	}
+
+	return false
+}
+
+// IsStatusByInt reports whether respCode is exactly the wanted status code
+func IsStatusByInt(respCode int, want int) bool {
+	return respCode == want
+}
@@ -0,0 +1,219 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v133/platformclientv2"
+)
+
+func TestUnitExponentialBackoffWithJitter(t *testing.T) {
+	base := 100 * time.Millisecond
+	max := time.Second
+	backoff := ExponentialBackoffWithJitter(base, max)
+
+	for attempt := 0; attempt < 64; attempt++ {
+		interval := max
+		if attempt < 4 {
+			interval = base << attempt
+		}
+		wait := backoff(attempt)
+		if wait < interval/2 || wait > interval {
+			t.Errorf("attempt %d: expected wait between %v and %v, got %v", attempt, interval/2, interval, wait)
+		}
+	}
+}
+
+func TestUnitWithRetriesOpts(t *testing.T) {
+	attempts := 0
+	diagErr := WithRetriesOpts(context.Background(), 5*time.Second, func() *retry.RetryError {
+		attempts++
+		if attempts < 3 {
+			return retry.RetryableError(fmt.Errorf("attempt %d failed", attempts))
+		}
+		return nil
+	}, WithBackoff(ConstantBackoff(time.Millisecond)))
+
+	if diagErr != nil {
+		t.Errorf("expected no error, got: %v", diagErr)
+	}
+	if attempts != 3 {
+		t.Errorf("expected 3 attempts, got %d", attempts)
+	}
+
+	attempts = 0
+	diagErr = WithRetriesOpts(context.Background(), 5*time.Second, func() *retry.RetryError {
+		attempts++
+		return retry.NonRetryableError(fmt.Errorf("permanent failure"))
+	}, WithBackoff(ConstantBackoff(time.Millisecond)))
+
+	if diagErr == nil {
+		t.Errorf("expected an error from a non-retryable failure")
+	}
+	if attempts != 1 {
+		t.Errorf("expected 1 attempt for a non-retryable error, got %d", attempts)
+	}
+}
+
+func TestUnitWithRetriesOptsTimeout(t *testing.T) {
+	diagErr := WithRetriesOpts(context.Background(), 50*time.Millisecond, func() *retry.RetryError {
+		return retry.RetryableError(fmt.Errorf("still pending"))
+	}, WithBackoff(ConstantBackoff(10*time.Millisecond)))
+
+	if diagErr == nil {
+		t.Fatalf("expected an error after the timeout elapsed")
+	}
+	if diagErr[0].Summary != "still pending" {
+		t.Errorf("expected the last retryable error to be returned, got: %s", diagErr[0].Summary)
+	}
+}
+
+func TestUnitParseRetryAfter(t *testing.T) {
+	type testCase struct {
+		name       string
+		statusCode int
+		header     map[string][]string
+		expected   time.Duration
+	}
+
+	testCases := []testCase{
+		{
+			name:       "seconds",
+			statusCode: http.StatusTooManyRequests,
+			header:     map[string][]string{"Retry-After": {"3"}},
+			expected:   3 * time.Second,
+		},
+		{
+			name:       "not a 429",
+			statusCode: http.StatusServiceUnavailable,
+			header:     map[string][]string{"Retry-After": {"3"}},
+			expected:   0,
+		},
+		{
+			name:       "missing header",
+			statusCode: http.StatusTooManyRequests,
+			expected:   0,
+		},
+		{
+			name:       "unparseable header",
+			statusCode: http.StatusTooManyRequests,
+			header:     map[string][]string{"Retry-After": {"soon"}},
+			expected:   0,
+		},
+		{
+			name:       "date in the past",
+			statusCode: http.StatusTooManyRequests,
+			header:     map[string][]string{"Retry-After": {"Wed, 21 Oct 2015 07:28:00 GMT"}},
+			expected:   0,
+		},
+	}
+
+	for _, tc := range testCases {
+		resp := &platformclientv2.APIResponse{StatusCode: tc.statusCode, Header: tc.header}
+		if actual := ParseRetryAfter(resp); actual != tc.expected {
+			t.Errorf("%s: expected %v, got %v", tc.name, tc.expected, actual)
+		}
+	}
+
+	if actual := ParseRetryAfter(nil); actual != 0 {
+		t.Errorf("expected 0 for a nil response, got %v", actual)
+	}
+
+	future := time.Now().Add(time.Minute).UTC().Format(http.TimeFormat)
+	resp := &platformclientv2.APIResponse{StatusCode: http.StatusTooManyRequests, Header: map[string][]string{"Retry-After": {future}}}
+	if actual := ParseRetryAfter(resp); actual <= 0 || actual > time.Minute {
+		t.Errorf("expected a wait of up to a minute for an HTTP date, got %v", actual)
+	}
+}
+
+func TestUnitWithRetriesOptsHonorsRetryAfter(t *testing.T) {
+	resp := &platformclientv2.APIResponse{StatusCode: http.StatusTooManyRequests, Header: map[string][]string{"Retry-After": {"1"}}}
+
+	attempts := 0
+	start := time.Now()
+	diagErr := WithRetriesOpts(context.Background(), 5*time.Second, func() *retry.RetryError {
+		attempts++
+		if attempts == 1 {
+			return RetryableErrorWithResponse(resp, fmt.Errorf("too many requests"))
+		}
+		return nil
+	}, WithBackoff(ConstantBackoff(time.Millisecond)))
+
+	if diagErr != nil {
+		t.Errorf("expected no error, got: %v", diagErr)
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("expected the retry to wait for the Retry-After duration, waited %v", elapsed)
+	}
+}
+
+func TestUnitIsStatusByInt(t *testing.T) {
+	if !IsStatus409ByInt(http.StatusConflict) || IsStatus409ByInt(http.StatusNotFound) {
+		t.Errorf("IsStatus409ByInt did not match only conflicts")
+	}
+	if !IsStatus429ByInt(http.StatusTooManyRequests) || IsStatus429ByInt(http.StatusConflict) {
+		t.Errorf("IsStatus429ByInt did not match only throttling responses")
+	}
+	if !IsStatus429ByInt(http.StatusServiceUnavailable, http.StatusServiceUnavailable) {
+		t.Errorf("IsStatus429ByInt did not honor additional codes")
+	}
+	if !IsStatus429(&platformclientv2.APIResponse{StatusCode: http.StatusTooManyRequests}) || IsStatus429(nil) {
+		t.Errorf("IsStatus429 did not match the response status")
+	}
+	if !IsGatewayErrorByInt(http.StatusBadGateway) || !IsGatewayErrorByInt(http.StatusServiceUnavailable) || !IsGatewayErrorByInt(http.StatusGatewayTimeout) {
+		t.Errorf("IsGatewayErrorByInt did not match gateway errors")
+	}
+	if IsGatewayErrorByInt(http.StatusNotFound) || IsGatewayErrorByInt(http.StatusInternalServerError) {
+		t.Errorf("IsGatewayErrorByInt matched a non-gateway error")
+	}
+	if !IsGatewayError(&platformclientv2.APIResponse{StatusCode: http.StatusGatewayTimeout}) || IsGatewayError(nil) {
+		t.Errorf("IsGatewayError did not match the response status")
+	}
+	if !IsStatusByInt(http.StatusConflict, http.StatusConflict) || IsStatusByInt(http.StatusRequestTimeout, http.StatusConflict) {
+		t.Errorf("IsStatusByInt did not require an exact match")
+	}
+}
+
+func TestUnitWithRetriesCancelledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	attempts := 0
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		cancel()
+	}()
+
+	start := time.Now()
+	diagErr := WithRetriesOpts(ctx, time.Minute, func() *retry.RetryError {
+		attempts++
+		return retry.RetryableError(fmt.Errorf("still pending"))
+	}, WithBackoff(ConstantBackoff(10*time.Second)))
+
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("expected retries to stop promptly after cancellation, took %v", elapsed)
+	}
+	if diagErr == nil || !strings.Contains(diagErr[0].Summary, "retries cancelled") {
+		t.Errorf("expected a cancellation error, got: %v", diagErr)
+	}
+	if attempts != 1 {
+		t.Errorf("expected 1 attempt before cancellation, got %d", attempts)
+	}
+
+	// An already cancelled context never calls the method
+	attempts = 0
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{}, map[string]interface{}{})
+	d.SetId("cancelled-read")
+	diagErr = WithRetriesForRead(ctx, d, func() *retry.RetryError {
+		attempts++
+		return nil
+	})
+	if diagErr == nil || attempts != 0 {
+		t.Errorf("expected a cancelled read to return an error without calling the method, got %v after %d attempts", diagErr, attempts)
+	}
+}
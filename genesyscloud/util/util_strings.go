@@ -3,6 +3,8 @@ package util
 import (
 	"regexp"
 	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 var matchFirstCap = regexp.MustCompile("(.)([A-Z][a-z]+)")
@@ -36,3 +38,8 @@ func StringExists(target string, slice []string) bool {
 	}
 	return false
 }
+
+// SuppressDiffFunc for enum properties that the API accepts in any case but returns in a canonical case
+func CaseInsensitiveDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	return strings.EqualFold(old, new)
+}
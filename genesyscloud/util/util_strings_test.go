@@ -0,0 +1,27 @@
+package util
+
+import (
+	"testing"
+)
+
+func TestUnitCaseInsensitiveDiffSuppress(t *testing.T) {
+	type testCase struct {
+		old      string
+		new      string
+		suppress bool
+	}
+
+	testCases := []testCase{
+		{old: "ALL", new: "ALL", suppress: true},
+		{old: "ALL", new: "all", suppress: true},
+		{old: "BEST", new: "Best", suppress: true},
+		{old: "ALL", new: "BEST", suppress: false},
+		{old: "", new: "ALL", suppress: false},
+	}
+
+	for _, tc := range testCases {
+		if actual := CaseInsensitiveDiffSuppress("skill_evaluation_method", tc.old, tc.new, nil); actual != tc.suppress {
+			t.Errorf("old %q new %q: expected suppress %v, got %v", tc.old, tc.new, tc.suppress, actual)
+		}
+	}
+}
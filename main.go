@@ -149,6 +149,8 @@ func main() {
 		opts.ProviderAddr = "registry.terraform.io/mypurecloud/genesyscloud"
 	}
 	plugin.Serve(opts)
+
+	provider.FlushApiMetrics()
 }
 
 type RegisterInstance struct {